@@ -0,0 +1,159 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type AksNodepoolFullName struct {
+	OrgID             string `json:"orgId,omitempty"`
+	CredentialName    string `json:"credentialName"`
+	SubscriptionID    string `json:"subscriptionId"`
+	ResourceGroupName string `json:"resourceGroupName"`
+	AksClusterName    string `json:"aksClusterName"`
+	Name              string `json:"name"`
+}
+
+type AksAutoScaling struct {
+	Enabled  bool `json:"enabled"`
+	MinCount int  `json:"minCount,omitempty"`
+	MaxCount int  `json:"maxCount,omitempty"`
+}
+
+type AksUpgradeConfig struct {
+	MaxSurge string `json:"maxSurge,omitempty"`
+}
+
+type AksNodepoolSpec struct {
+	Mode               string                 `json:"mode"`
+	Type               string                 `json:"type,omitempty"`
+	AvailabilityZones  []string               `json:"availabilityZones,omitempty"`
+	Count              int                    `json:"count"`
+	VmSize             string                 `json:"vmSize"`
+	OsType             string                 `json:"osType,omitempty"`
+	OsDiskType         string                 `json:"osDiskType,omitempty"`
+	OsDiskSizeGb       int                    `json:"osDiskSizeGb,omitempty"`
+	MaxPods            int                    `json:"maxPods,omitempty"`
+	EnableNodePublicIp bool                   `json:"enableNodePublicIp"`
+	VnetSubnetID       string                 `json:"vnetSubnetId,omitempty"`
+	NodeLabels         map[string]interface{} `json:"nodeLabels,omitempty"`
+	Tags               map[string]interface{} `json:"tags,omitempty"`
+	AutoScaling        *AksAutoScaling        `json:"autoScaling,omitempty"`
+	UpgradeConfig      *AksUpgradeConfig      `json:"upgradeConfig,omitempty"`
+}
+
+type AksNodepool struct {
+	FullName *AksNodepoolFullName `json:"fullName"`
+	Meta     *MetaData            `json:"meta"`
+	Spec     *AksNodepoolSpec     `json:"spec"`
+	Status   *Status              `json:"status"`
+}
+
+type AksNodepoolJsonObject struct {
+	Nodepool AksNodepool `json:"nodepool"`
+}
+
+func (c *Client) GetAksNodepool(name string, clusterName string, credentialName string, subscriptionID string, resourceGroupName string) (*AksNodepool, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/aksclusters/%s/nodepools/%s?fullName.credentialName=%s&fullName.subscriptionId=%s&fullName.resourceGroupName=%s", c.baseURL, clusterName, name, credentialName, subscriptionID, resourceGroupName)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := AksNodepoolJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Nodepool, nil
+}
+
+func (c *Client) CreateAksNodepool(nodepool *AksNodepool) (*AksNodepool, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/aksclusters/%s/nodepools", c.baseURL, nodepool.FullName.AksClusterName)
+
+	newNodepoolObject := &AksNodepoolJsonObject{
+		Nodepool: *nodepool,
+	}
+
+	json_data, err := json.Marshal(newNodepoolObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := AksNodepoolJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Nodepool, nil
+}
+
+func (c *Client) UpdateAksNodepool(nodepool *AksNodepool) (*AksNodepool, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/aksclusters/%s/nodepools/%s", c.baseURL, nodepool.FullName.AksClusterName, nodepool.FullName.Name)
+
+	nodepoolObject := &AksNodepoolJsonObject{
+		Nodepool: *nodepool,
+	}
+
+	json_data, err := json.Marshal(nodepoolObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := AksNodepoolJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Nodepool, nil
+}
+
+func (c *Client) DeleteAksNodepool(name string, clusterName string, credentialName string, subscriptionID string, resourceGroupName string) error {
+	requestURL := fmt.Sprintf("%s/v1alpha1/aksclusters/%s/nodepools/%s?fullName.credentialName=%s&fullName.subscriptionId=%s&fullName.resourceGroupName=%s", c.baseURL, clusterName, name, credentialName, subscriptionID, resourceGroupName)
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := AksNodepoolJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DescribeAksNodepool(name string, clusterName string, credentialName string, subscriptionID string, resourceGroupName string) (*Status, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/aksclusters/%s/nodepools/%s?fullName.credentialName=%s&fullName.subscriptionId=%s&fullName.resourceGroupName=%s", c.baseURL, clusterName, name, credentialName, subscriptionID, resourceGroupName)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.describeRequest(req, func(body *json.Decoder) (*Status, error) {
+		res := AksNodepoolJsonObject{}
+		if err := body.Decode(&res); err != nil {
+			return nil, err
+		}
+		return res.Nodepool.Status, nil
+	})
+}
@@ -0,0 +1,175 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type AksFullName struct {
+	OrgID             string `json:"orgId,omitempty"`
+	CredentialName    string `json:"credentialName"`
+	SubscriptionID    string `json:"subscriptionId"`
+	ResourceGroupName string `json:"resourceGroupName"`
+	Name              string `json:"name"`
+}
+
+type AksSku struct {
+	Tier string `json:"tier,omitempty"`
+}
+
+type AksAccessConfig struct {
+	EnableRbac           bool `json:"enableRbac"`
+	DisableLocalAccounts bool `json:"disableLocalAccounts,omitempty"`
+}
+
+type AksApiServerAccessConfig struct {
+	AuthorizedIpRanges   []string `json:"authorizedIpRanges,omitempty"`
+	EnablePrivateCluster bool     `json:"enablePrivateCluster"`
+}
+
+type AksNetworkConfig struct {
+	NetworkPlugin    string   `json:"networkPlugin"`
+	NetworkPolicy    string   `json:"networkPolicy,omitempty"`
+	LoadBalancerSku  string   `json:"loadBalancerSku,omitempty"`
+	DnsPrefix        string   `json:"dnsPrefix"`
+	DnsServiceIp     string   `json:"dnsServiceIp,omitempty"`
+	DockerBridgeCidr string   `json:"dockerBridgeCidr,omitempty"`
+	PodCidrs         []string `json:"podCidrs,omitempty"`
+	ServiceCidrs     []string `json:"serviceCidrs,omitempty"`
+}
+
+type AksConfig struct {
+	Location              string                    `json:"location"`
+	Version               string                    `json:"version"`
+	NodeResourceGroupName string                    `json:"nodeResourceGroupName,omitempty"`
+	Tags                  map[string]interface{}    `json:"tags,omitempty"`
+	Sku                   *AksSku                   `json:"sku,omitempty"`
+	AccessConfig          *AksAccessConfig          `json:"accessConfig,omitempty"`
+	ApiServerAccessConfig *AksApiServerAccessConfig `json:"apiServerAccessConfig,omitempty"`
+	NetworkConfig         *AksNetworkConfig         `json:"networkConfig"`
+}
+
+type AksClusterSpec struct {
+	ClusterGroupName string     `json:"clusterGroupName"`
+	ProxyName        string     `json:"proxyName,omitempty"`
+	Config           *AksConfig `json:"config"`
+}
+
+type AksCluster struct {
+	FullName *AksFullName    `json:"fullName"`
+	Meta     *MetaData       `json:"meta"`
+	Spec     *AksClusterSpec `json:"spec"`
+	Status   *Status         `json:"status"`
+}
+
+type AksClusterJsonObject struct {
+	AksCluster AksCluster `json:"aksCluster"`
+}
+
+func (c *Client) GetAksCluster(name string, credentialName string, subscriptionID string, resourceGroupName string) (*AksCluster, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/aksclusters/%s?fullName.credentialName=%s&fullName.subscriptionId=%s&fullName.resourceGroupName=%s", c.baseURL, name, credentialName, subscriptionID, resourceGroupName)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := AksClusterJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.AksCluster, nil
+}
+
+func (c *Client) CreateAksCluster(cluster *AksCluster) (*AksCluster, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/aksclusters", c.baseURL)
+
+	newClusterObject := &AksClusterJsonObject{
+		AksCluster: *cluster,
+	}
+
+	json_data, err := json.Marshal(newClusterObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := AksClusterJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.AksCluster, nil
+}
+
+// Updates an existing AKS cluster. The cluster object must carry the
+// resource version returned by the last read of the cluster.
+func (c *Client) UpdateAksCluster(cluster *AksCluster) (*AksCluster, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/aksclusters/%s", c.baseURL, cluster.FullName.Name)
+
+	clusterObject := &AksClusterJsonObject{
+		AksCluster: *cluster,
+	}
+
+	json_data, err := json.Marshal(clusterObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := AksClusterJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.AksCluster, nil
+}
+
+func (c *Client) DeleteAksCluster(name string, credentialName string, subscriptionID string, resourceGroupName string) error {
+	requestURL := fmt.Sprintf("%s/v1alpha1/aksclusters/%s?fullName.credentialName=%s&fullName.subscriptionId=%s&fullName.resourceGroupName=%s", c.baseURL, name, credentialName, subscriptionID, resourceGroupName)
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := AksClusterJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns the phase of an AKS cluster, reporting DELETED once TMC no longer knows about it.
+func (c *Client) DescribeAksCluster(name string, credentialName string, subscriptionID string, resourceGroupName string) (*Status, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/aksclusters/%s?fullName.credentialName=%s&fullName.subscriptionId=%s&fullName.resourceGroupName=%s", c.baseURL, name, credentialName, subscriptionID, resourceGroupName)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.describeRequest(req, func(body *json.Decoder) (*Status, error) {
+		res := AksClusterJsonObject{}
+		if err := body.Decode(&res); err != nil {
+			return nil, err
+		}
+		return res.AksCluster.Status, nil
+	})
+}
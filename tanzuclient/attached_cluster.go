@@ -0,0 +1,149 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// Attached clusters are not provisioned by TMC, they are registered under
+// the "attached" management cluster and provisioner.
+const AttachedClusterManagementClusterName = "attached"
+
+type AttachedClusterSpec struct {
+	ClusterGroupName string `json:"clusterGroupName"`
+	ProxyName        string `json:"proxyName,omitempty"`
+}
+
+type AttachedCluster struct {
+	FullName *FullName            `json:"fullName"`
+	Meta     *MetaData            `json:"meta"`
+	Spec     *AttachedClusterSpec `json:"spec"`
+	Status   *ClusterStatus       `json:"status,omitempty"`
+}
+
+type AttachedClusterJSONObject struct {
+	Cluster AttachedCluster `json:"cluster"`
+}
+
+func (c *Client) GetAttachedCluster(name string) (*AttachedCluster, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/clusters/%s?fullName.managementClusterName=%s&fullName.provisionerName=%s", c.baseURL, name, AttachedClusterManagementClusterName, AttachedClusterManagementClusterName)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := AttachedClusterJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Cluster, nil
+}
+
+func (c *Client) CreateAttachedCluster(name string, clusterGroup string, proxyName string, description string, labels map[string]interface{}) (*AttachedCluster, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/clusters", c.baseURL)
+
+	newClusterObject := &AttachedClusterJSONObject{
+		Cluster: AttachedCluster{
+			FullName: &FullName{
+				Name:                  name,
+				ManagementClusterName: AttachedClusterManagementClusterName,
+				ProvisionerName:       AttachedClusterManagementClusterName,
+			},
+			Meta: &MetaData{
+				Description: description,
+				Labels:      labels,
+			},
+			Spec: &AttachedClusterSpec{
+				ClusterGroupName: clusterGroup,
+				ProxyName:        proxyName,
+			},
+		},
+	}
+
+	json_data, err := json.Marshal(newClusterObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := AttachedClusterJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Cluster, nil
+}
+
+func (c *Client) UpdateAttachedCluster(name string, clusterGroup string, proxyName string, description string, resourceVersion string, labels map[string]interface{}) (*AttachedCluster, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/clusters/%s?fullName.managementClusterName=%s&fullName.provisionerName=%s", c.baseURL, name, AttachedClusterManagementClusterName, AttachedClusterManagementClusterName)
+
+	clusterObject := &AttachedClusterJSONObject{
+		Cluster: AttachedCluster{
+			FullName: &FullName{
+				Name:                  name,
+				ManagementClusterName: AttachedClusterManagementClusterName,
+				ProvisionerName:       AttachedClusterManagementClusterName,
+			},
+			Meta: &MetaData{
+				ResourceVersion: resourceVersion,
+				Description:     description,
+				Labels:          labels,
+			},
+			Spec: &AttachedClusterSpec{
+				ClusterGroupName: clusterGroup,
+				ProxyName:        proxyName,
+			},
+		},
+	}
+
+	json_data, err := json.Marshal(clusterObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := AttachedClusterJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Cluster, nil
+}
+
+// Downloads the agent installer manifest of an attached cluster. The installer
+// link already embeds a short-lived token, so no TMC credentials are sent along.
+func (c *Client) GetAttachedClusterManifest(installerLink string) ([]byte, error) {
+	req, err := http.NewRequest("GET", installerLink, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("unable to download installer manifest, status code: %d", res.StatusCode)
+	}
+
+	return ioutil.ReadAll(res.Body)
+}
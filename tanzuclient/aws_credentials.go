@@ -116,3 +116,31 @@ func (c *Client) CreateAwsCredential(cred *TmcAwsCredential) (*TmcAwsCredential,
 
 	return &res.TmcAwsCredential, nil
 }
+
+func (c *Client) UpdateAwsCredential(cred *TmcAwsCredential) (*TmcAwsCredential, error) {
+
+	requestURL := fmt.Sprintf("%s/v1alpha1/account/credentials/%s", c.baseURL, cred.FullName.Name)
+
+	credObject := &TmcAwsCredentialResponse{
+		TmcAwsCredential: *cred,
+	}
+
+	// Create JSON object for the request Body
+	json_data, err := json.Marshal(credObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := TmcAwsCredentialResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.TmcAwsCredential, nil
+}
@@ -0,0 +1,107 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type TmcAzureCredential struct {
+	FullName *FullName            `json:"fullName"`
+	Meta     *MetaData            `json:"meta"`
+	Spec     *AzureCredentialSpec `json:"spec"`
+	Status   struct {
+		Phase string `json:"phase,omitempty"`
+	} `json:"status,omitempty"`
+}
+
+type AzureCredentialSpec struct {
+	MetaData   *CredentialMetaData  `json:"meta"`
+	Capability string               `json:"capability"`
+	Data       *AzureCredentialData `json:"data"`
+}
+
+type AzureCredentialData struct {
+	AzureCredential *AzureCredential `json:"azureCredential"`
+}
+
+type AzureCredential struct {
+	ServicePrincipal *AzureServicePrincipal `json:"servicePrincipal"`
+}
+
+type AzureServicePrincipal struct {
+	SubscriptionID string `json:"subscriptionId"`
+	TenantID       string `json:"tenantId"`
+	ResourceGroup  string `json:"resourceGroup,omitempty"`
+	ClientID       string `json:"clientId"`
+	ClientSecret   string `json:"clientSecret,omitempty"`
+	AzureCloudName string `json:"azureCloudName,omitempty"`
+}
+
+type TmcAzureCredentialResponse struct {
+	TmcAzureCredential TmcAzureCredential `json:"credential"`
+}
+
+func (c *Client) GetAzureCredential(name string) (*TmcAzureCredential, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/account/credentials/%s", c.baseURL, name)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := TmcAzureCredentialResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.TmcAzureCredential, nil
+}
+
+// Deletes an already existing Azure credential with a given name.
+func (c *Client) DeleteAzureCredential(name string) error {
+	requestURL := fmt.Sprintf("%s/v1alpha1/account/credentials/%s", c.baseURL, name)
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := TmcAzureCredentialResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) CreateAzureCredential(cred *TmcAzureCredential) (*TmcAzureCredential, error) {
+
+	requestURL := fmt.Sprintf("%s/v1alpha1/account/credentials", c.baseURL)
+
+	newCredObject := &TmcAzureCredentialResponse{
+		TmcAzureCredential: *cred,
+	}
+
+	// Create JSON object for the request Body
+	json_data, err := json.Marshal(newCredObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := TmcAzureCredentialResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.TmcAzureCredential, nil
+}
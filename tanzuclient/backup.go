@@ -5,13 +5,34 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 type TmcClusterBackup struct {
-	FullName *FullName          `json:"fullName"`
-	Meta     *MetaData          `json:"meta"`
-	Status   *Status            `json:"status"`
-	Spec     *ClusterBackupSpec `json:"spec"`
+	FullName *FullName            `json:"fullName"`
+	Meta     *MetaData            `json:"meta"`
+	Status   *ClusterBackupStatus `json:"status"`
+	Spec     *ClusterBackupSpec   `json:"spec"`
+}
+
+type ClusterBackupProgress struct {
+	TotalItems    int `json:"totalItems,omitempty"`
+	ItemsBackedUp int `json:"itemsBackedUp,omitempty"`
+}
+
+// ClusterBackupStatus is the status Velero reports for a backup. The 64 bit size
+// is encoded as a string by the TMC API.
+type ClusterBackupStatus struct {
+	Phase                    string                 `json:"phase,omitempty"`
+	StartTimestamp           string                 `json:"startTimestamp,omitempty"`
+	CompletionTimestamp      string                 `json:"completionTimestamp,omitempty"`
+	Expiration               string                 `json:"expiration,omitempty"`
+	Progress                 *ClusterBackupProgress `json:"progress,omitempty"`
+	VolumeSnapshotsAttempted int                    `json:"volumeSnapshotsAttempted,omitempty"`
+	VolumeSnapshotsCompleted int                    `json:"volumeSnapshotsCompleted,omitempty"`
+	Errors                   int                    `json:"errors,omitempty"`
+	Warnings                 int                    `json:"warnings,omitempty"`
+	TotalSize                string                 `json:"totalSize,omitempty"`
 }
 
 type ClusterBackupSpec struct {
@@ -53,18 +74,38 @@ func (c *Client) DeleteClusterBackup(name string, mgmt_cluster_name string, clus
 
 	req, err := http.NewRequest("DELETE", requestURL, nil)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	res := TmcClusterBackupResponse{}
 
 	if err := c.sendRequest(req, &res); err != nil {
-		return nil
+		return err
 	}
 
 	return nil
 }
 
+func (c *Client) DescribeClusterBackup(name string, mgmt_cluster_name string, cluster_name string, provisioner_name string) (*Status, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/clusters/%s/dataprotection/backups/%s?fullName.managementClusterName=%s&fullName.provisionerName=%s", c.baseURL, cluster_name, name, mgmt_cluster_name, provisioner_name)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.describeRequest(req, func(body *json.Decoder) (*Status, error) {
+		res := TmcClusterBackupResponse{}
+		if err := body.Decode(&res); err != nil {
+			return nil, err
+		}
+		if res.Backup.Status == nil {
+			return nil, nil
+		}
+		return &Status{Phase: res.Backup.Status.Phase}, nil
+	})
+}
+
 func (c *Client) CreateClusterBackup(clusterName string, backup *TmcClusterBackup) (*TmcClusterBackup, error) {
 
 	requestURL := fmt.Sprintf("%s/v1alpha1/clusters/%s/dataprotection/backups", c.baseURL, clusterName)
@@ -92,3 +133,37 @@ func (c *Client) CreateClusterBackup(clusterName string, backup *TmcClusterBacku
 
 	return &res.Backup, nil
 }
+
+type TmcClusterBackupListResponse struct {
+	Backups    []TmcClusterBackup `json:"backups"`
+	TotalCount string             `json:"totalCount"`
+}
+
+// Lists the backups of a cluster which match the query.
+func (c *Client) ListClusterBackups(mgmt_cluster_name string, cluster_name string, provisioner_name string, query *Query) ([]TmcClusterBackup, error) {
+	params := url.Values{}
+	params.Set("searchScope.managementClusterName", mgmt_cluster_name)
+	params.Set("searchScope.provisionerName", provisioner_name)
+	if q := query.String(); q != "" {
+		params.Set("query", q)
+	}
+
+	backups := make([]TmcClusterBackup, 0)
+
+	err := c.sendListRequest(fmt.Sprintf("%s/v1alpha1/clusters/%s/dataprotection/backups", c.baseURL, cluster_name), params, func(req *http.Request) (int, string, error) {
+		res := TmcClusterBackupListResponse{}
+
+		if err := c.sendRequest(req, &res); err != nil {
+			return 0, "", err
+		}
+
+		backups = append(backups, res.Backups...)
+
+		return len(res.Backups), res.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return backups, nil
+}
@@ -0,0 +1,202 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Providers of the targets backups are stored in. S3-compatible targets, e.g. MinIO,
+// use the AWS provider with the URL of the endpoint.
+const (
+	BackupLocationTmcProvider   = "TMC"
+	BackupLocationAwsProvider   = "AWS"
+	BackupLocationAzureProvider = "AZURE"
+)
+
+type BackupLocationCredential struct {
+	Name string `json:"name"`
+}
+
+type BackupLocationAssignedGroup struct {
+	ClusterGroup *BackupLocationClusterGroup `json:"clustergroup,omitempty"`
+}
+
+type BackupLocationClusterGroup struct {
+	Name string `json:"name"`
+}
+
+type BackupLocationAwsConfig struct {
+	S3ForcePathStyle bool   `json:"s3ForcePathStyle,omitempty"`
+	S3Url            string `json:"s3Url,omitempty"`
+	PublicUrl        string `json:"publicUrl,omitempty"`
+}
+
+type BackupLocationAzureConfig struct {
+	ResourceGroup  string `json:"resourceGroup"`
+	StorageAccount string `json:"storageAccount"`
+	SubscriptionID string `json:"subscriptionId"`
+}
+
+type BackupLocationConfig struct {
+	AwsConfig   *BackupLocationAwsConfig   `json:"awsConfig,omitempty"`
+	AzureConfig *BackupLocationAzureConfig `json:"azureConfig,omitempty"`
+}
+
+type BackupLocationSpec struct {
+	TargetProvider string                        `json:"targetProvider"`
+	Credential     *BackupLocationCredential     `json:"credential,omitempty"`
+	Bucket         string                        `json:"bucket,omitempty"`
+	Region         string                        `json:"region,omitempty"`
+	CaCert         string                        `json:"caCert,omitempty"`
+	Config         *BackupLocationConfig         `json:"config,omitempty"`
+	AssignedGroups []BackupLocationAssignedGroup `json:"assignedGroups,omitempty"`
+}
+
+type BackupLocation struct {
+	FullName *FullName           `json:"fullName"`
+	Meta     *MetaData           `json:"meta"`
+	Spec     *BackupLocationSpec `json:"spec"`
+	Status   *Status             `json:"status"`
+}
+
+type BackupLocationJSONObject struct {
+	BackupLocation BackupLocation `json:"backupLocation"`
+}
+
+type BackupLocationListResponse struct {
+	BackupLocations []BackupLocation `json:"backupLocations"`
+	TotalCount      string           `json:"totalCount"`
+}
+
+func (c *Client) GetBackupLocation(name string) (*BackupLocation, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/dataprotection/providers/tmc/backuplocations/%s", c.baseURL, url.PathEscape(name))
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := BackupLocationJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.BackupLocation, nil
+}
+
+func (c *Client) CreateBackupLocation(name string, labels map[string]interface{}, spec *BackupLocationSpec) (*BackupLocation, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/dataprotection/providers/tmc/backuplocations", c.baseURL)
+
+	newLocationObject := &BackupLocationJSONObject{
+		BackupLocation: BackupLocation{
+			FullName: &FullName{
+				Name: name,
+			},
+			Meta: &MetaData{
+				Labels: labels,
+			},
+			Spec: spec,
+		},
+	}
+
+	json_data, err := json.Marshal(newLocationObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := BackupLocationJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.BackupLocation, nil
+}
+
+func (c *Client) UpdateBackupLocation(name string, labels map[string]interface{}, resourceVersion string, spec *BackupLocationSpec) (*BackupLocation, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/dataprotection/providers/tmc/backuplocations/%s", c.baseURL, url.PathEscape(name))
+
+	locationObject := &BackupLocationJSONObject{
+		BackupLocation: BackupLocation{
+			FullName: &FullName{
+				Name: name,
+			},
+			Meta: &MetaData{
+				Labels:          labels,
+				ResourceVersion: resourceVersion,
+			},
+			Spec: spec,
+		},
+	}
+
+	json_data, err := json.Marshal(locationObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := BackupLocationJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.BackupLocation, nil
+}
+
+func (c *Client) DeleteBackupLocation(name string) error {
+	requestURL := fmt.Sprintf("%s/v1alpha1/dataprotection/providers/tmc/backuplocations/%s", c.baseURL, url.PathEscape(name))
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := BackupLocationJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Lists the backup locations which match the query, following the pagination
+// of the TMC API until every page has been read.
+func (c *Client) ListBackupLocations(query *Query) ([]BackupLocation, error) {
+	params := url.Values{}
+	if q := query.String(); q != "" {
+		params.Set("query", q)
+	}
+	locations := make([]BackupLocation, 0)
+
+	err := c.sendListRequest(fmt.Sprintf("%s/v1alpha1/dataprotection/providers/tmc/backuplocations", c.baseURL), params, func(req *http.Request) (int, string, error) {
+		res := BackupLocationListResponse{}
+
+		if err := c.sendRequest(req, &res); err != nil {
+			return 0, "", err
+		}
+
+		locations = append(locations, res.BackupLocations...)
+
+		return len(res.BackupLocations), res.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return locations, nil
+}
@@ -0,0 +1,128 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type BackupScheduleRate struct {
+	// Cron expression of the schedule, e.g. "0 2 * * *"
+	Rate string `json:"rate"`
+}
+
+// The backups of a schedule are created from its template, which is the spec of a one-shot backup
+type BackupScheduleSpec struct {
+	Paused   bool                `json:"paused"`
+	Schedule *BackupScheduleRate `json:"schedule"`
+	Template *ClusterBackupSpec  `json:"template"`
+}
+
+type TmcBackupSchedule struct {
+	FullName *FullName           `json:"fullName"`
+	Meta     *MetaData           `json:"meta"`
+	Status   *Status             `json:"status"`
+	Spec     *BackupScheduleSpec `json:"spec"`
+}
+
+type TmcBackupScheduleResponse struct {
+	Schedule TmcBackupSchedule `json:"schedule"`
+}
+
+func backupScheduleURL(baseURL string, name string, mgmtClusterName string, clusterName string, provisionerName string) string {
+	params := url.Values{}
+	params.Set("fullName.managementClusterName", mgmtClusterName)
+	params.Set("fullName.provisionerName", provisionerName)
+
+	return fmt.Sprintf("%s/v1alpha1/clusters/%s/dataprotection/schedules/%s?%s", baseURL, url.PathEscape(clusterName), url.PathEscape(name), params.Encode())
+}
+
+func (c *Client) GetBackupSchedule(name string, mgmtClusterName string, clusterName string, provisionerName string) (*TmcBackupSchedule, error) {
+	requestURL := backupScheduleURL(c.baseURL, name, mgmtClusterName, clusterName, provisionerName)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := TmcBackupScheduleResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Schedule, nil
+}
+
+func (c *Client) CreateBackupSchedule(schedule *TmcBackupSchedule) (*TmcBackupSchedule, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/clusters/%s/dataprotection/schedules", c.baseURL, url.PathEscape(schedule.FullName.ClusterName))
+
+	newScheduleObject := &TmcBackupScheduleResponse{
+		Schedule: *schedule,
+	}
+
+	json_data, err := json.Marshal(newScheduleObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := TmcBackupScheduleResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Schedule, nil
+}
+
+// Replaces the spec and labels of a schedule. The resource version of the meta
+// must be the one last read, for concurrent changes not to be overwritten.
+func (c *Client) UpdateBackupSchedule(schedule *TmcBackupSchedule) (*TmcBackupSchedule, error) {
+	requestURL := backupScheduleURL(c.baseURL, schedule.FullName.Name, schedule.FullName.ManagementClusterName, schedule.FullName.ClusterName, schedule.FullName.ProvisionerName)
+
+	scheduleObject := &TmcBackupScheduleResponse{
+		Schedule: *schedule,
+	}
+
+	json_data, err := json.Marshal(scheduleObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := TmcBackupScheduleResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Schedule, nil
+}
+
+func (c *Client) DeleteBackupSchedule(name string, mgmtClusterName string, clusterName string, provisionerName string) error {
+	requestURL := backupScheduleURL(c.baseURL, name, mgmtClusterName, clusterName, provisionerName)
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := TmcBackupScheduleResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -5,9 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 )
 
+// Number of items requested per page from the list endpoints of the TMC API
+const listPageSize = 100
+
 // Client is a client for working with the TMC Web API.
 // It is created by `NewClient`.
 type Client struct {
@@ -79,3 +84,76 @@ func (c *Client) sendRequest(req *http.Request, v interface{}) error {
 
 	return nil
 }
+
+// describeRequest polls a resource which is being deleted. A resource that no longer
+// exists is reported in the DELETED phase, otherwise the phase is taken from the
+// decoded response, falling back to DELETING when TMC does not report one.
+func (c *Client) describeRequest(req *http.Request, decode func(*json.Decoder) (*Status, error)) (*Status, error) {
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token.Token))
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return &Status{Phase: "ERROR"}, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return &Status{Phase: "DELETED"}, nil
+	}
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes errorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return &Status{Phase: "ERROR"}, errors.New(errRes.Message)
+		}
+
+		return &Status{Phase: "ERROR"}, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	status, err := decode(json.NewDecoder(res.Body))
+	if err != nil {
+		return &Status{Phase: "ERROR"}, err
+	}
+
+	if status == nil || status.Phase == "" {
+		return &Status{Phase: "DELETING"}, nil
+	}
+
+	return status, nil
+}
+
+// sendListRequest reads every page of a list endpoint. The page callback sends the request
+// for a single page, collects its items and returns the number of items on the page along
+// with the total count reported by TMC.
+func (c *Client) sendListRequest(requestURL string, params url.Values, page func(req *http.Request) (int, string, error)) error {
+	params.Set("pagination.size", strconv.Itoa(listPageSize))
+
+	offset := 0
+
+	for {
+		params.Set("pagination.offset", strconv.Itoa(offset))
+
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s?%s", requestURL, params.Encode()), nil)
+		if err != nil {
+			return err
+		}
+
+		count, totalCount, err := page(req)
+		if err != nil {
+			return err
+		}
+
+		offset += count
+
+		// TMC may return smaller pages than requested, only the total count tells
+		// whether pages are left. Without it the list ends on the first empty page,
+		// which also ends it when the total count overstates the number of items.
+		if total, err := strconv.Atoi(totalCount); err == nil && offset >= total {
+			return nil
+		}
+		if count == 0 {
+			return nil
+		}
+	}
+}
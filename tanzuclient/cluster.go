@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
@@ -58,10 +59,10 @@ type ClusterSpec struct {
 }
 
 type Cluster struct {
-	FullName *FullName    `json:"fullName"`
-	Meta     *MetaData    `json:"meta"`
-	Spec     *ClusterSpec `json:"spec"`
-	Status   *Status      `json:"status"`
+	FullName *FullName      `json:"fullName"`
+	Meta     *MetaData      `json:"meta"`
+	Spec     *ClusterSpec   `json:"spec"`
+	Status   *ClusterStatus `json:"status"`
 }
 
 type ClusterJSONObject struct {
@@ -240,6 +241,126 @@ func (c *Client) DescribeCluster(fullName string, managementClusterName string,
 	return &Status{Phase: "DELETING"}, nil
 }
 
+// Narrows down the clusters returned by ListClusters. Empty fields match any value,
+// the name may contain the * wildcard.
+type ClusterSearchScope struct {
+	Name                  string
+	ManagementClusterName string
+	ProvisionerName       string
+}
+
+type ClusterListResponse struct {
+	Clusters   []Cluster `json:"clusters"`
+	TotalCount string    `json:"totalCount"`
+}
+
+// Lists all clusters within the search scope which match the query, following
+// the pagination of the TMC API until every page has been read.
+func (c *Client) ListClusters(scope *ClusterSearchScope, query *Query) ([]Cluster, error) {
+	params := url.Values{}
+	if scope != nil {
+		if scope.Name != "" {
+			params.Set("searchScope.name", scope.Name)
+		}
+		if scope.ManagementClusterName != "" {
+			params.Set("searchScope.managementClusterName", scope.ManagementClusterName)
+		}
+		if scope.ProvisionerName != "" {
+			params.Set("searchScope.provisionerName", scope.ProvisionerName)
+		}
+	}
+	if q := query.String(); q != "" {
+		params.Set("query", q)
+	}
+	clusters := make([]Cluster, 0)
+
+	err := c.sendListRequest(fmt.Sprintf("%s/v1alpha1/clusters", c.baseURL), params, func(req *http.Request) (int, string, error) {
+		res := ClusterListResponse{}
+
+		if err := c.sendRequest(req, &res); err != nil {
+			return 0, "", err
+		}
+
+		clusters = append(clusters, res.Clusters...)
+
+		return len(res.Clusters), res.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return clusters, nil
+}
+
+type ClusterKubeconfigResponse struct {
+	Kubeconfig string `json:"kubeconfig"`
+}
+
+// Returns the admin kubeconfig of a cluster provisioned by TMC. The kubeconfig
+// authenticates with the credentials of the cluster's administrator.
+func (c *Client) GetClusterAdminKubeconfig(name string, managementClusterName string, provisionerName string) (string, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/clusters/%s/adminkubeconfig?fullName.managementClusterName=%s&fullName.provisionerName=%s", c.baseURL, name, managementClusterName, provisionerName)
+
+	return c.getClusterKubeconfig(requestURL)
+}
+
+// Returns the Pinniped based kubeconfig of a cluster. The kubeconfig authenticates
+// the user through the Tanzu CLI, which must be available where it is used.
+func (c *Client) GetClusterPinnipedKubeconfig(name string, managementClusterName string, provisionerName string) (string, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/clusters/%s/kubeconfig?fullName.managementClusterName=%s&fullName.provisionerName=%s", c.baseURL, name, managementClusterName, provisionerName)
+
+	return c.getClusterKubeconfig(requestURL)
+}
+
+func (c *Client) getClusterKubeconfig(requestURL string) (string, error) {
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	res := ClusterKubeconfigResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return "", err
+	}
+
+	return res.Kubeconfig, nil
+}
+
+type ClusterExtension struct {
+	FullName *FullName `json:"fullName"`
+	Meta     *MetaData `json:"meta"`
+	Spec     struct {
+		Version string `json:"version,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		Phase  string `json:"phase,omitempty"`
+		Health string `json:"health,omitempty"`
+	} `json:"status"`
+}
+
+type ClusterExtensionListResponse struct {
+	Extensions []ClusterExtension `json:"extensions"`
+}
+
+// Lists the TMC extensions, such as the cluster agent components, installed on a cluster.
+func (c *Client) ListClusterExtensions(clusterName string, managementClusterName string, provisionerName string) ([]ClusterExtension, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/clusters/%s/extensions?fullName.managementClusterName=%s&fullName.provisionerName=%s", c.baseURL, clusterName, managementClusterName, provisionerName)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := ClusterExtensionListResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Extensions, nil
+}
+
 func buildAwsJsonObject(opts *ClusterOpts) AWSCluster {
 
 	var newAwsSpec AWSCluster
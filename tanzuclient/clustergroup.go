@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 type ClusterGroup struct {
@@ -140,11 +141,9 @@ func (c *Client) UpdateClusterGroup(name string, description string, labels map[
 	return &res.ClusterGroup, nil
 }
 
-func (c *Client) GetAllClusterGroups(labels map[string]interface{}) (*[]ClusterGroup, error) {
+func (c *Client) GetAllClusterGroups(query *Query) (*[]ClusterGroup, error) {
 
-	queryString := buildLabelQuery(labels)
-
-	requestURL := c.baseURL + "/v1alpha1/clustergroups?query=" + queryString
+	requestURL := c.baseURL + "/v1alpha1/clustergroups?query=" + url.QueryEscape(query.String())
 
 	req, err := http.NewRequest("GET", requestURL, nil)
 	if err != nil {
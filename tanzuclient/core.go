@@ -2,6 +2,7 @@ package tanzuclient
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -25,6 +26,52 @@ type Status struct {
 	Phase string `json:"phase,omitempty"`
 }
 
+// ClusterStatus is the status TMC reports for a cluster. Besides the phase of the
+// cluster's lifecycle it carries the health reported by the cluster agent.
+type ClusterStatus struct {
+	Phase             string                      `json:"phase,omitempty"`
+	Health            string                      `json:"health,omitempty"`
+	HealthDetails     *ClusterHealthDetails       `json:"healthDetails,omitempty"`
+	Conditions        map[string]ClusterCondition `json:"conditions,omitempty"`
+	AgentVersion      string                      `json:"agentVersion,omitempty"`
+	KubeServerVersion string                      `json:"kubeServerVersion,omitempty"`
+	InstallerLink     string                      `json:"installerLink,omitempty"`
+	AllocatedCpu      *ClusterAllocatedResource   `json:"allocatedCpu,omitempty"`
+	AllocatedMemory   *ClusterAllocatedResource   `json:"allocatedMemory,omitempty"`
+	NodeCount         int                         `json:"nodeCount,omitempty"`
+	WorkerNodeCount   int                         `json:"workerNodeCount,omitempty"`
+}
+
+type ClusterCondition struct {
+	Type               string `json:"type"`
+	Status             string `json:"status"`
+	Severity           string `json:"severity,omitempty"`
+	Reason             string `json:"reason,omitempty"`
+	Message            string `json:"message,omitempty"`
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+}
+
+type ClusterHealthDetails struct {
+	ControllerManagerHealth *ComponentHealth  `json:"controllerManagerHealth,omitempty"`
+	SchedulerHealth         *ComponentHealth  `json:"schedulerHealth,omitempty"`
+	ComponentHealth         []ComponentHealth `json:"componentHealth,omitempty"`
+	Message                 string            `json:"message,omitempty"`
+}
+
+type ComponentHealth struct {
+	Name   string `json:"name"`
+	Health string `json:"health"`
+}
+
+// Allocatable and requested amounts of a resource, in the given units
+// (millicores for CPU and MiB for memory).
+type ClusterAllocatedResource struct {
+	Allocatable         int     `json:"allocatable"`
+	Requested           int     `json:"requested"`
+	AllocatedPercentage float64 `json:"allocatedPercentage"`
+	Units               string  `json:"units"`
+}
+
 type LabelSelector struct {
 	MatchLabels      map[string]interface{} `json:"matchLabels,omitempty"`
 	MatchExpressions []MatchExpressions     `json:"matchExpressions,omitempty"`
@@ -41,23 +88,139 @@ type errorResponse struct {
 	Message string `json:"message"`
 }
 
-func buildLabelQuery(labels map[string]interface{}) string {
+// Query builds the query parameter of the TMC list endpoints. The terms of a group are
+// ANDed together and the groups of a query are ORed together. Terms are rendered in the
+// order they were added, with labels sorted by key, so the same filters always yield the
+// same query.
+type Query struct {
+	groups []*QueryGroup
+}
+
+type QueryGroup struct {
+	terms []string
+}
+
+func NewQuery() *Query {
+	return &Query{}
+}
+
+// Adds a new group of terms to the query, which is ORed with the other groups.
+func (q *Query) Group() *QueryGroup {
+	g := &QueryGroup{}
+	q.groups = append(q.groups, g)
+	return g
+}
+
+func (q *Query) String() string {
+	if q == nil || len(q.groups) == 0 {
+		return ""
+	}
+
+	groups := make([]string, 0, len(q.groups))
+	for _, g := range q.groups {
+		// A group without terms matches everything, and so does the whole query
+		if len(g.terms) == 0 {
+			return ""
+		}
+		groups = append(groups, strings.Join(g.terms, " and "))
+	}
+
+	if len(groups) == 1 {
+		return groups[0]
+	}
+
+	return "(" + strings.Join(groups, ") or (") + ")"
+}
+
+// Matches resources where the field equals the value.
+func (g *QueryGroup) Equals(field string, value string) *QueryGroup {
+	g.terms = append(g.terms, fmt.Sprintf("%s:%s", escapeQueryField(field), escapeQueryValue(value)))
+	return g
+}
+
+// Matches resources where the field equals any of the values.
+func (g *QueryGroup) In(field string, values []string) *QueryGroup {
+	if len(values) > 0 {
+		g.terms = append(g.terms, anyOfQueryTerm(field, values))
+	}
+	return g
+}
+
+// Matches resources where the field equals none of the values.
+func (g *QueryGroup) NotIn(field string, values []string) *QueryGroup {
+	if len(values) > 0 {
+		g.terms = append(g.terms, "not "+anyOfQueryTerm(field, values))
+	}
+	return g
+}
+
+// Matches resources where the field is set.
+func (g *QueryGroup) Exists(field string) *QueryGroup {
+	g.terms = append(g.terms, fmt.Sprintf("%s:*", escapeQueryField(field)))
+	return g
+}
+
+// Matches resources where the field is not set.
+func (g *QueryGroup) NotExists(field string) *QueryGroup {
+	g.terms = append(g.terms, fmt.Sprintf("not %s:*", escapeQueryField(field)))
+	return g
+}
+
+// Matches resources where the field starts with the prefix.
+func (g *QueryGroup) HasPrefix(field string, prefix string) *QueryGroup {
+	g.terms = append(g.terms, fmt.Sprintf("%s:%s*", escapeQueryField(field), escapeQueryValue(prefix)))
+	return g
+}
+
+// Matches resources carrying all the labels.
+func (g *QueryGroup) MatchLabels(labels map[string]interface{}) *QueryGroup {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
 
-	var query strings.Builder
-	var labelArray []string
+	for _, k := range keys {
+		g.Equals(LabelQueryField(k), fmt.Sprint(labels[k]))
+	}
+	return g
+}
+
+// Returns the query field of the label with the given key.
+func LabelQueryField(key string) string {
+	return "meta.labels." + key
+}
 
-	for k, v := range labels {
-		newFilter := fmt.Sprintf("meta.labels.%s:%s", k, v)
-		labelArray = append(labelArray, newFilter)
+func anyOfQueryTerm(field string, values []string) string {
+	terms := make([]string, len(values))
+	for i, v := range values {
+		terms[i] = fmt.Sprintf("%s:%s", escapeQueryField(field), escapeQueryValue(v))
 	}
 
-	for i, label := range labelArray {
-		query.WriteString(label)
-		if i == len(labelArray)-1 {
-			break
+	if len(terms) == 1 {
+		return terms[0]
+	}
+
+	return "(" + strings.Join(terms, " or ") + ")"
+}
+
+// Characters with a meaning in the TMC query language, which must be backslash escaped
+// to be matched literally.
+const queryReservedCharacters = `\+-!(){}[]^"~*?:/&| `
+
+func escapeQueryValue(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if strings.ContainsRune(queryReservedCharacters, r) {
+			b.WriteRune('\\')
 		}
-		query.WriteString(" and ")
+		b.WriteRune(r)
 	}
+	return b.String()
+}
 
-	return query.String()
+// Field paths are separated by dots, every other reserved character, such as the slash of
+// a prefixed label key, is escaped.
+func escapeQueryField(field string) string {
+	return escapeQueryValue(field)
 }
@@ -0,0 +1,159 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Custom policy templates are OPA Gatekeeper constraint templates
+const (
+	CustomPolicyTemplateObjectType   = "ConstraintTemplate"
+	CustomPolicyTemplateTemplateType = "OPAGatekeeper"
+)
+
+// A Kubernetes resource type synced into the data inventory of the OPA engine,
+// for the Rego of a template to look up other objects of the cluster.
+type DataInventory struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+type CustomPolicyTemplateSpec struct {
+	ObjectType    string          `json:"objectType"`
+	TemplateType  string          `json:"templateType"`
+	DataInventory []DataInventory `json:"dataInventory,omitempty"`
+	IsDeprecated  bool            `json:"isDeprecated,omitempty"`
+	// Manifest of the constraint template, as YAML or JSON
+	Object string `json:"object"`
+}
+
+type CustomPolicyTemplate struct {
+	FullName *FullName                 `json:"fullName"`
+	Meta     *MetaData                 `json:"meta"`
+	Spec     *CustomPolicyTemplateSpec `json:"spec"`
+}
+
+type CustomPolicyTemplateJSONObject struct {
+	Template CustomPolicyTemplate `json:"template"`
+}
+
+func (c *Client) GetCustomPolicyTemplate(name string) (*CustomPolicyTemplate, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/policy/templates/%s", c.baseURL, url.PathEscape(name))
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := CustomPolicyTemplateJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Template, nil
+}
+
+func (c *Client) CreateCustomPolicyTemplate(name string, description string, spec *CustomPolicyTemplateSpec) (*CustomPolicyTemplate, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/policy/templates", c.baseURL)
+
+	newTemplateObject := &CustomPolicyTemplateJSONObject{
+		Template: CustomPolicyTemplate{
+			FullName: &FullName{
+				Name: name,
+			},
+			Meta: &MetaData{
+				Description: description,
+			},
+			Spec: spec,
+		},
+	}
+
+	json_data, err := json.Marshal(newTemplateObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := CustomPolicyTemplateJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Template, nil
+}
+
+func (c *Client) UpdateCustomPolicyTemplate(name string, description string, resourceVersion string, spec *CustomPolicyTemplateSpec) (*CustomPolicyTemplate, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/policy/templates/%s", c.baseURL, url.PathEscape(name))
+
+	templateObject := &CustomPolicyTemplateJSONObject{
+		Template: CustomPolicyTemplate{
+			FullName: &FullName{
+				Name: name,
+			},
+			Meta: &MetaData{
+				Description:     description,
+				ResourceVersion: resourceVersion,
+			},
+			Spec: spec,
+		},
+	}
+
+	json_data, err := json.Marshal(templateObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := CustomPolicyTemplateJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Template, nil
+}
+
+func (c *Client) DeleteCustomPolicyTemplate(name string) error {
+	requestURL := fmt.Sprintf("%s/v1alpha1/policy/templates/%s", c.baseURL, url.PathEscape(name))
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := CustomPolicyTemplateJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Target Kubernetes resources a custom policy applies to
+type TargetKubernetesResources struct {
+	ApiGroups []string `json:"apiGroups"`
+	Kinds     []string `json:"kinds"`
+}
+
+// CustomPolicyInput is the input of a custom policy, whose recipe is the name
+// of its template. The parameters follow the schema of the template.
+type CustomPolicyInput struct {
+	Audit                     bool                        `json:"audit"`
+	Parameters                json.RawMessage             `json:"parameters,omitempty"`
+	TargetKubernetesResources []TargetKubernetesResources `json:"targetKubernetesResources"`
+}
@@ -0,0 +1,163 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DataProtectionSpec configures the Velero installed by TMC on a cluster. Restic and its
+// successor, the node agent, back up the persistent volumes through the file system.
+type DataProtectionSpec struct {
+	DisableRestic                   bool `json:"disableRestic"`
+	DefaultVolumesToFsBackup        bool `json:"defaultVolumesToFsBackup"`
+	UseNodeAgent                    bool `json:"useNodeAgent"`
+	EnableCsiSnapshots              bool `json:"enableCsiSnapshots"`
+	EnableAllApiGroupVersionsBackup bool `json:"enableAllApiGroupVersionsBackup"`
+}
+
+type DataProtection struct {
+	FullName *FullName           `json:"fullName"`
+	Meta     *MetaData           `json:"meta"`
+	Spec     *DataProtectionSpec `json:"spec"`
+	Status   *Status             `json:"status"`
+}
+
+type DataProtectionJSONObject struct {
+	DataProtection DataProtection `json:"dataProtection"`
+}
+
+func dataProtectionURL(baseURL string, mgmtClusterName string, clusterName string, provisionerName string, params url.Values) string {
+	params.Set("fullName.managementClusterName", mgmtClusterName)
+	params.Set("fullName.provisionerName", provisionerName)
+
+	return fmt.Sprintf("%s/v1alpha1/clusters/%s/dataprotection?%s", baseURL, url.PathEscape(clusterName), params.Encode())
+}
+
+func (c *Client) GetDataProtection(mgmtClusterName string, clusterName string, provisionerName string) (*DataProtection, error) {
+	requestURL := dataProtectionURL(c.baseURL, mgmtClusterName, clusterName, provisionerName, url.Values{})
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := DataProtectionJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.DataProtection, nil
+}
+
+// Enables data protection on a cluster, which installs Velero through the cluster agent.
+func (c *Client) EnableDataProtection(mgmtClusterName string, clusterName string, provisionerName string, spec *DataProtectionSpec) (*DataProtection, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/clusters/%s/dataprotection", c.baseURL, url.PathEscape(clusterName))
+
+	dataProtectionObject := &DataProtectionJSONObject{
+		DataProtection: DataProtection{
+			FullName: &FullName{
+				ManagementClusterName: mgmtClusterName,
+				ProvisionerName:       provisionerName,
+				ClusterName:           clusterName,
+			},
+			Spec: spec,
+		},
+	}
+
+	json_data, err := json.Marshal(dataProtectionObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := DataProtectionJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.DataProtection, nil
+}
+
+func (c *Client) UpdateDataProtection(mgmtClusterName string, clusterName string, provisionerName string, resourceVersion string, spec *DataProtectionSpec) (*DataProtection, error) {
+	requestURL := dataProtectionURL(c.baseURL, mgmtClusterName, clusterName, provisionerName, url.Values{})
+
+	dataProtectionObject := &DataProtectionJSONObject{
+		DataProtection: DataProtection{
+			FullName: &FullName{
+				ManagementClusterName: mgmtClusterName,
+				ProvisionerName:       provisionerName,
+				ClusterName:           clusterName,
+			},
+			Meta: &MetaData{
+				ResourceVersion: resourceVersion,
+			},
+			Spec: spec,
+		},
+	}
+
+	json_data, err := json.Marshal(dataProtectionObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := DataProtectionJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.DataProtection, nil
+}
+
+// Disables data protection on a cluster, uninstalling Velero. The backups of the
+// cluster are deleted from their storage locations unless they are kept.
+func (c *Client) DisableDataProtection(mgmtClusterName string, clusterName string, provisionerName string, keepBackups bool) error {
+	params := url.Values{}
+	params.Set("deleteBackups", strconv.FormatBool(!keepBackups))
+	requestURL := dataProtectionURL(c.baseURL, mgmtClusterName, clusterName, provisionerName, params)
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := DataProtectionJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DescribeDataProtection(mgmtClusterName string, clusterName string, provisionerName string) (*Status, error) {
+	requestURL := dataProtectionURL(c.baseURL, mgmtClusterName, clusterName, provisionerName, url.Values{})
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.describeRequest(req, func(body *json.Decoder) (*Status, error) {
+		res := DataProtectionJSONObject{}
+		if err := body.Decode(&res); err != nil {
+			return nil, err
+		}
+		return res.DataProtection.Status, nil
+	})
+}
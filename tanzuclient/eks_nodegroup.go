@@ -0,0 +1,160 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type EksNodegroupFullName struct {
+	OrgID          string `json:"orgId,omitempty"`
+	CredentialName string `json:"credentialName"`
+	Region         string `json:"region"`
+	EksClusterName string `json:"eksClusterName"`
+	Name           string `json:"name"`
+}
+
+type EksRemoteAccess struct {
+	SshKey         string   `json:"sshKey,omitempty"`
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+}
+
+type EksScalingConfig struct {
+	DesiredSize int `json:"desiredSize"`
+	MaxSize     int `json:"maxSize"`
+	MinSize     int `json:"minSize"`
+}
+
+type EksUpdateConfig struct {
+	MaxUnavailableNodes      int `json:"maxUnavailableNodes,omitempty"`
+	MaxUnavailablePercentage int `json:"maxUnavailablePercentage,omitempty"`
+}
+
+type EksNodegroupSpec struct {
+	RoleArn       string                 `json:"roleArn"`
+	AmiType       string                 `json:"amiType,omitempty"`
+	CapacityType  string                 `json:"capacityType,omitempty"`
+	RootDiskSize  int                    `json:"rootDiskSize,omitempty"`
+	Tags          map[string]interface{} `json:"tags,omitempty"`
+	NodeLabels    map[string]interface{} `json:"nodeLabels,omitempty"`
+	SubnetIds     []string               `json:"subnetIds"`
+	InstanceTypes []string               `json:"instanceTypes,omitempty"`
+	RemoteAccess  *EksRemoteAccess       `json:"remoteAccess,omitempty"`
+	ScalingConfig *EksScalingConfig      `json:"scalingConfig"`
+	UpdateConfig  *EksUpdateConfig       `json:"updateConfig,omitempty"`
+}
+
+type EksNodegroup struct {
+	FullName *EksNodegroupFullName `json:"fullName"`
+	Meta     *MetaData             `json:"meta"`
+	Spec     *EksNodegroupSpec     `json:"spec"`
+	Status   *Status               `json:"status"`
+}
+
+type EksNodegroupJsonObject struct {
+	Nodegroup EksNodegroup `json:"nodepool"`
+}
+
+func (c *Client) GetEksNodegroup(name string, clusterName string, credentialName string, region string) (*EksNodegroup, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/eksclusters/%s/nodepools/%s?fullName.credentialName=%s&fullName.region=%s", c.baseURL, clusterName, name, credentialName, region)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := EksNodegroupJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Nodegroup, nil
+}
+
+func (c *Client) CreateEksNodegroup(nodegroup *EksNodegroup) (*EksNodegroup, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/eksclusters/%s/nodepools", c.baseURL, nodegroup.FullName.EksClusterName)
+
+	newNodegroupObject := &EksNodegroupJsonObject{
+		Nodegroup: *nodegroup,
+	}
+
+	json_data, err := json.Marshal(newNodegroupObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := EksNodegroupJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Nodegroup, nil
+}
+
+func (c *Client) UpdateEksNodegroup(nodegroup *EksNodegroup) (*EksNodegroup, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/eksclusters/%s/nodepools/%s", c.baseURL, nodegroup.FullName.EksClusterName, nodegroup.FullName.Name)
+
+	nodegroupObject := &EksNodegroupJsonObject{
+		Nodegroup: *nodegroup,
+	}
+
+	json_data, err := json.Marshal(nodegroupObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := EksNodegroupJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Nodegroup, nil
+}
+
+func (c *Client) DeleteEksNodegroup(name string, clusterName string, credentialName string, region string) error {
+	requestURL := fmt.Sprintf("%s/v1alpha1/eksclusters/%s/nodepools/%s?fullName.credentialName=%s&fullName.region=%s", c.baseURL, clusterName, name, credentialName, region)
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := EksNodegroupJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DescribeEksNodegroup(name string, clusterName string, credentialName string, region string) (*Status, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/eksclusters/%s/nodepools/%s?fullName.credentialName=%s&fullName.region=%s", c.baseURL, clusterName, name, credentialName, region)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.describeRequest(req, func(body *json.Decoder) (*Status, error) {
+		res := EksNodegroupJsonObject{}
+		if err := body.Decode(&res); err != nil {
+			return nil, err
+		}
+		return res.Nodegroup.Status, nil
+	})
+}
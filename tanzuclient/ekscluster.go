@@ -0,0 +1,167 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type EksFullName struct {
+	OrgID          string `json:"orgId,omitempty"`
+	CredentialName string `json:"credentialName"`
+	Region         string `json:"region"`
+	Name           string `json:"name"`
+}
+
+type EksKubernetesNetworkConfig struct {
+	ServiceCidr string `json:"serviceCidr,omitempty"`
+}
+
+type EksLogging struct {
+	ApiServer         bool `json:"apiServer"`
+	Audit             bool `json:"audit"`
+	Authenticator     bool `json:"authenticator"`
+	ControllerManager bool `json:"controllerManager"`
+	Scheduler         bool `json:"scheduler"`
+}
+
+type EksVpcConfig struct {
+	EnablePrivateAccess bool     `json:"enablePrivateAccess"`
+	EnablePublicAccess  bool     `json:"enablePublicAccess"`
+	PublicAccessCidrs   []string `json:"publicAccessCidrs,omitempty"`
+	SecurityGroups      []string `json:"securityGroups,omitempty"`
+	SubnetIds           []string `json:"subnetIds"`
+}
+
+type EksConfig struct {
+	Version                 string                      `json:"version"`
+	RoleArn                 string                      `json:"roleArn"`
+	Tags                    map[string]interface{}      `json:"tags,omitempty"`
+	KubernetesNetworkConfig *EksKubernetesNetworkConfig `json:"kubernetesNetworkConfig,omitempty"`
+	Logging                 *EksLogging                 `json:"logging,omitempty"`
+	Vpc                     *EksVpcConfig               `json:"vpc"`
+}
+
+type EksClusterSpec struct {
+	ClusterGroupName string     `json:"clusterGroupName"`
+	ProxyName        string     `json:"proxyName,omitempty"`
+	Config           *EksConfig `json:"config"`
+}
+
+type EksCluster struct {
+	FullName *EksFullName    `json:"fullName"`
+	Meta     *MetaData       `json:"meta"`
+	Spec     *EksClusterSpec `json:"spec"`
+	Status   *Status         `json:"status"`
+}
+
+type EksClusterJsonObject struct {
+	EksCluster EksCluster `json:"eksCluster"`
+}
+
+func (c *Client) GetEksCluster(name string, credentialName string, region string) (*EksCluster, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/eksclusters/%s?fullName.credentialName=%s&fullName.region=%s", c.baseURL, name, credentialName, region)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := EksClusterJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.EksCluster, nil
+}
+
+func (c *Client) CreateEksCluster(cluster *EksCluster) (*EksCluster, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/eksclusters", c.baseURL)
+
+	newClusterObject := &EksClusterJsonObject{
+		EksCluster: *cluster,
+	}
+
+	json_data, err := json.Marshal(newClusterObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := EksClusterJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.EksCluster, nil
+}
+
+// Updates an existing EKS cluster. The cluster object must carry the
+// resource version returned by the last read of the cluster.
+func (c *Client) UpdateEksCluster(cluster *EksCluster) (*EksCluster, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/eksclusters/%s", c.baseURL, cluster.FullName.Name)
+
+	clusterObject := &EksClusterJsonObject{
+		EksCluster: *cluster,
+	}
+
+	json_data, err := json.Marshal(clusterObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := EksClusterJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.EksCluster, nil
+}
+
+func (c *Client) DeleteEksCluster(name string, credentialName string, region string) error {
+	requestURL := fmt.Sprintf("%s/v1alpha1/eksclusters/%s?fullName.credentialName=%s&fullName.region=%s", c.baseURL, name, credentialName, region)
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := EksClusterJsonObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns the phase of an EKS cluster, reporting DELETED once TMC no longer knows about it.
+func (c *Client) DescribeEksCluster(name string, credentialName string, region string) (*Status, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/eksclusters/%s?fullName.credentialName=%s&fullName.region=%s", c.baseURL, name, credentialName, region)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.describeRequest(req, func(body *json.Decoder) (*Status, error) {
+		res := EksClusterJsonObject{}
+		if err := body.Decode(&res); err != nil {
+			return nil, err
+		}
+		return res.EksCluster.Status, nil
+	})
+}
@@ -0,0 +1,239 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Kinds of the identities a role can be bound to
+const (
+	SubjectKindUser           = "USER"
+	SubjectKindGroup          = "GROUP"
+	SubjectKindServiceAccount = "SERVICEACCOUNT"
+)
+
+type Subject struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+type RoleBinding struct {
+	Role     string    `json:"role"`
+	Subjects []Subject `json:"subjects"`
+}
+
+// AccessPolicy holds the role bindings of an object of the resource hierarchy.
+type AccessPolicy struct {
+	Meta         *MetaData     `json:"meta,omitempty"`
+	RoleBindings []RoleBinding `json:"roleBindings"`
+}
+
+type AccessPolicyJSONObject struct {
+	Policy AccessPolicy `json:"policy"`
+}
+
+type AccessPolicyListResponse struct {
+	PolicyList []AccessPolicy `json:"policyList"`
+}
+
+// A single role binding to add to or remove from an access policy
+type RoleBindingDelta struct {
+	Op      string   `json:"op"`
+	Role    string   `json:"role"`
+	Subject *Subject `json:"subject"`
+}
+
+type RoleBindingDeltaRequest struct {
+	BindingDeltaList []RoleBindingDelta `json:"bindingDeltaList"`
+}
+
+// Returns the access policy attached directly to the object of the scope. TMC lists the
+// object's own policy first, an object without bindings yields an empty policy.
+func (c *Client) GetAccessPolicy(scope *Scope) (*AccessPolicy, error) {
+	requestURL, err := scope.requestURL(c.baseURL, ":iam")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := AccessPolicyListResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	if len(res.PolicyList) == 0 {
+		return &AccessPolicy{}, nil
+	}
+
+	return &res.PolicyList[0], nil
+}
+
+// Replaces all the role bindings of the object of the scope.
+func (c *Client) SetAccessPolicy(scope *Scope, resourceVersion string, roleBindings []RoleBinding) (*AccessPolicy, error) {
+	requestURL, err := scope.requestURL(c.baseURL, ":iam")
+	if err != nil {
+		return nil, err
+	}
+
+	if roleBindings == nil {
+		roleBindings = []RoleBinding{}
+	}
+
+	policyObject := &AccessPolicyJSONObject{
+		Policy: AccessPolicy{
+			Meta: &MetaData{
+				ResourceVersion: resourceVersion,
+			},
+			RoleBindings: roleBindings,
+		},
+	}
+
+	json_data, err := json.Marshal(policyObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := AccessPolicyJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Policy, nil
+}
+
+// Adds a role binding to the access policy of the object of the scope,
+// leaving the other bindings untouched.
+func (c *Client) AddRoleBinding(scope *Scope, role string, subject *Subject) (*AccessPolicy, error) {
+	return c.patchRoleBinding(scope, "ADD", role, subject)
+}
+
+// Removes a role binding from the access policy of the object of the scope,
+// leaving the other bindings untouched.
+func (c *Client) DeleteRoleBinding(scope *Scope, role string, subject *Subject) (*AccessPolicy, error) {
+	return c.patchRoleBinding(scope, "DELETE", role, subject)
+}
+
+func (c *Client) patchRoleBinding(scope *Scope, op string, role string, subject *Subject) (*AccessPolicy, error) {
+	requestURL, err := scope.requestURL(c.baseURL, ":iam")
+	if err != nil {
+		return nil, err
+	}
+
+	deltaObject := &RoleBindingDeltaRequest{
+		BindingDeltaList: []RoleBindingDelta{
+			{
+				Op:      op,
+				Role:    role,
+				Subject: subject,
+			},
+		},
+	}
+
+	json_data, err := json.Marshal(deltaObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := AccessPolicyJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Policy, nil
+}
+
+// A Kubernetes RBAC rule granted by a role on the clusters it applies to
+type RoleRule struct {
+	ApiGroups     []string `json:"apiGroups,omitempty"`
+	Resources     []string `json:"resources,omitempty"`
+	ResourceNames []string `json:"resourceNames,omitempty"`
+	Verbs         []string `json:"verbs,omitempty"`
+}
+
+type RoleSpec struct {
+	// Types of the objects of the resource hierarchy the role can be bound on,
+	// e.g. CLUSTER_GROUP or NAMESPACE
+	Resources        []string   `json:"resources,omitempty"`
+	TanzuPermissions []string   `json:"tanzuPermissions,omitempty"`
+	Rules            []RoleRule `json:"rules,omitempty"`
+	IsInbuilt        bool       `json:"isInbuilt"`
+	IsDeprecated     bool       `json:"isDeprecated"`
+}
+
+type Role struct {
+	FullName *FullName `json:"fullName"`
+	Meta     *MetaData `json:"meta"`
+	Spec     *RoleSpec `json:"spec"`
+}
+
+type RoleJSONObject struct {
+	Role Role `json:"role"`
+}
+
+type RoleListResponse struct {
+	Roles      []Role `json:"roles"`
+	TotalCount string `json:"totalCount"`
+}
+
+func (c *Client) GetRole(name string) (*Role, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/iam/roles/%s", c.baseURL, url.PathEscape(name))
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := RoleJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Role, nil
+}
+
+// Lists the built-in and custom roles of the organization which match the query.
+func (c *Client) ListRoles(query *Query) ([]Role, error) {
+	params := url.Values{}
+	if q := query.String(); q != "" {
+		params.Set("query", q)
+	}
+	roles := make([]Role, 0)
+
+	err := c.sendListRequest(fmt.Sprintf("%s/v1alpha1/iam/roles", c.baseURL), params, func(req *http.Request) (int, string, error) {
+		res := RoleListResponse{}
+
+		if err := c.sendRequest(req, &res); err != nil {
+			return 0, "", err
+		}
+
+		roles = append(roles, res.Roles...)
+
+		return len(res.Roles), res.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,29 @@
+package tanzuclient
+
+// Recipes of the image policies
+const (
+	ImagePolicyAllowedNameTagRecipe = "allowed-name-tag"
+	ImagePolicyCustomRecipe         = "custom"
+	ImagePolicyBlockLatestTagRecipe = "block-latest-tag"
+	ImagePolicyRequireDigestRecipe  = "require-digest"
+)
+
+type ImagePolicyTag struct {
+	Value  string `json:"value"`
+	Negate bool   `json:"negate,omitempty"`
+}
+
+// Rule matching the images allowed by an image policy. The allowed-name-tag recipe only
+// matches image names and tags, the custom recipe supports every field.
+type ImagePolicyRule struct {
+	Hostname      string          `json:"hostname,omitempty"`
+	ImageName     string          `json:"imageName,omitempty"`
+	Port          string          `json:"port,omitempty"`
+	RequireDigest bool            `json:"requireDigest,omitempty"`
+	Tag           *ImagePolicyTag `json:"tag,omitempty"`
+}
+
+type ImagePolicyInput struct {
+	Audit bool              `json:"audit"`
+	Rules []ImagePolicyRule `json:"rules,omitempty"`
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 type NamespaceSpec struct {
@@ -105,3 +106,37 @@ func (c *Client) DeleteNamespace(name string, clusterName string, managementClus
 
 	return nil
 }
+
+type NamespaceListResponse struct {
+	Namespaces []Namespace `json:"namespaces"`
+	TotalCount string      `json:"totalCount"`
+}
+
+// Lists the namespaces of a cluster which match the query.
+func (c *Client) ListNamespaces(clusterName string, managementClusterName string, provisionerName string, query *Query) ([]Namespace, error) {
+	params := url.Values{}
+	params.Set("searchScope.managementClusterName", managementClusterName)
+	params.Set("searchScope.provisionerName", provisionerName)
+	if q := query.String(); q != "" {
+		params.Set("query", q)
+	}
+
+	namespaces := make([]Namespace, 0)
+
+	err := c.sendListRequest(fmt.Sprintf("%s/v1alpha1/clusters/%s/namespaces", c.baseURL, clusterName), params, func(req *http.Request) (int, string, error) {
+		res := NamespaceListResponse{}
+
+		if err := c.sendRequest(req, &res); err != nil {
+			return 0, "", err
+		}
+
+		namespaces = append(namespaces, res.Namespaces...)
+
+		return len(res.Namespaces), res.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return namespaces, nil
+}
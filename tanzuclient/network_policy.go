@@ -0,0 +1,54 @@
+package tanzuclient
+
+// Recipes of the network policies
+const (
+	NetworkPolicyAllowAllRecipe       = "allow-all"
+	NetworkPolicyAllowAllToPodsRecipe = "allow-all-to-pods"
+	NetworkPolicyDenyAllRecipe        = "deny-all"
+	NetworkPolicyDenyAllToPodsRecipe  = "deny-all-to-pods"
+	NetworkPolicyCustomEgressRecipe   = "custom-egress"
+	NetworkPolicyCustomIngressRecipe  = "custom-ingress"
+)
+
+type PolicyLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type NetworkPolicyPort struct {
+	Port     string `json:"port"`
+	Protocol string `json:"protocol"`
+}
+
+type NetworkPolicyPodSelector struct {
+	PodSelector []PolicyLabel `json:"podSelector"`
+}
+
+type NetworkPolicyNamespaceSelector struct {
+	NamespaceSelector []PolicyLabel `json:"namespaceSelector"`
+}
+
+type NetworkPolicyIPBlock struct {
+	Cidr   string   `json:"cidr"`
+	Except []string `json:"except,omitempty"`
+}
+
+// A peer of a custom network policy rule, only one of the selectors is set
+type NetworkPolicyRuleSpec struct {
+	PodSelector       *NetworkPolicyPodSelector       `json:"podSelector,omitempty"`
+	NamespaceSelector *NetworkPolicyNamespaceSelector `json:"namespaceSelector,omitempty"`
+	IPBlock           *NetworkPolicyIPBlock           `json:"ipBlock,omitempty"`
+}
+
+type NetworkPolicyRule struct {
+	Ports    []NetworkPolicyPort     `json:"ports,omitempty"`
+	RuleSpec []NetworkPolicyRuleSpec `json:"ruleSpec,omitempty"`
+}
+
+// NetworkPolicyInput is the input of every network policy recipe. Each recipe
+// only accepts some of the fields, deny-all accepts none.
+type NetworkPolicyInput struct {
+	FromOwnNamespace *bool               `json:"fromOwnNamespace,omitempty"`
+	ToPodLabels      []PolicyLabel       `json:"toPodLabels,omitempty"`
+	Rules            []NetworkPolicyRule `json:"rules,omitempty"`
+}
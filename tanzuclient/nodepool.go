@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
@@ -199,3 +200,37 @@ func (c *Client) DescribeNodePool(name string, clusterName string, managementClu
 
 	return &Status{Phase: "DELETING"}, nil
 }
+
+type NodePoolListResponse struct {
+	NodePools  []NodePool `json:"nodepools"`
+	TotalCount string     `json:"totalCount"`
+}
+
+// Lists the nodepools of a cluster which match the query.
+func (c *Client) ListNodePools(clusterName string, managementClusterName string, provisionerName string, query *Query) ([]NodePool, error) {
+	params := url.Values{}
+	params.Set("searchScope.managementClusterName", managementClusterName)
+	params.Set("searchScope.provisionerName", provisionerName)
+	if q := query.String(); q != "" {
+		params.Set("query", q)
+	}
+
+	nodePools := make([]NodePool, 0)
+
+	err := c.sendListRequest(fmt.Sprintf("%s/v1alpha1/clusters/%s/nodepools", c.baseURL, clusterName), params, func(req *http.Request) (int, string, error) {
+		res := NodePoolListResponse{}
+
+		if err := c.sendRequest(req, &res); err != nil {
+			return 0, "", err
+		}
+
+		nodePools = append(nodePools, res.NodePools...)
+
+		return len(res.NodePools), res.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nodePools, nil
+}
@@ -0,0 +1,177 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Types of the policies TMC enforces on the clusters of a scope
+const (
+	SecurityPolicyType = "security-policy"
+	ImagePolicyType    = "image-policy"
+	NetworkPolicyType  = "network-policy"
+	QuotaPolicyType    = "namespace-quota-policy"
+	CustomPolicyType   = "custom-policy"
+)
+
+// The name of a policy is qualified by the object of the scope it is attached to
+type PolicyFullName struct {
+	OrgID                 string `json:"orgId,omitempty"`
+	Name                  string `json:"name"`
+	ClusterGroupName      string `json:"clusterGroupName,omitempty"`
+	WorkspaceName         string `json:"workspaceName,omitempty"`
+	ClusterName           string `json:"clusterName,omitempty"`
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+	ProvisionerName       string `json:"provisionerName,omitempty"`
+}
+
+// PolicySpec is shared by every type of policy. The recipe selects one of the
+// predefined flavours of the policy type, and the schema of the input depends
+// on both the type and the recipe.
+type PolicySpec struct {
+	Type              string          `json:"type"`
+	Recipe            string          `json:"recipe"`
+	RecipeVersion     string          `json:"recipeVersion,omitempty"`
+	Input             json.RawMessage `json:"input,omitempty"`
+	NamespaceSelector *LabelSelector  `json:"namespaceSelector,omitempty"`
+}
+
+type Policy struct {
+	FullName *PolicyFullName `json:"fullName"`
+	Meta     *MetaData       `json:"meta"`
+	Spec     *PolicySpec     `json:"spec"`
+}
+
+type PolicyJSONObject struct {
+	Policy Policy `json:"policy"`
+}
+
+func newPolicyFullName(scope *Scope, name string) *PolicyFullName {
+	fullName := &PolicyFullName{Name: name}
+
+	switch scope.Type {
+	case ClusterGroupScope:
+		fullName.ClusterGroupName = scope.Name
+	case WorkspaceScope:
+		fullName.WorkspaceName = scope.Name
+	case ClusterScope:
+		fullName.ClusterName = scope.Name
+		fullName.ManagementClusterName = scope.ManagementClusterName
+		fullName.ProvisionerName = scope.ProvisionerName
+	}
+
+	return fullName
+}
+
+func (c *Client) GetPolicy(scope *Scope, name string) (*Policy, error) {
+	requestURL, err := scope.requestURL(c.baseURL, "/policies/"+url.PathEscape(name))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := PolicyJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Policy, nil
+}
+
+func (c *Client) CreatePolicy(scope *Scope, name string, description string, spec *PolicySpec) (*Policy, error) {
+	requestURL, err := scope.requestURL(c.baseURL, "/policies")
+	if err != nil {
+		return nil, err
+	}
+
+	newPolicyObject := &PolicyJSONObject{
+		Policy: Policy{
+			FullName: newPolicyFullName(scope, name),
+			Meta: &MetaData{
+				Description: description,
+			},
+			Spec: spec,
+		},
+	}
+
+	json_data, err := json.Marshal(newPolicyObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := PolicyJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Policy, nil
+}
+
+func (c *Client) UpdatePolicy(scope *Scope, name string, description string, resourceVersion string, spec *PolicySpec) (*Policy, error) {
+	requestURL, err := scope.requestURL(c.baseURL, "/policies/"+url.PathEscape(name))
+	if err != nil {
+		return nil, err
+	}
+
+	policyObject := &PolicyJSONObject{
+		Policy: Policy{
+			FullName: newPolicyFullName(scope, name),
+			Meta: &MetaData{
+				Description:     description,
+				ResourceVersion: resourceVersion,
+			},
+			Spec: spec,
+		},
+	}
+
+	json_data, err := json.Marshal(policyObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := PolicyJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Policy, nil
+}
+
+func (c *Client) DeletePolicy(scope *Scope, name string) error {
+	requestURL, err := scope.requestURL(c.baseURL, "/policies/"+url.PathEscape(name))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := PolicyJSONObject{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package tanzuclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// The Kubernetes object a policy issue was reported on
+type PolicyIssueTarget struct {
+	ApiVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+type PolicyIssueFullName struct {
+	OrgID                 string `json:"orgId,omitempty"`
+	Name                  string `json:"name"`
+	ClusterName           string `json:"clusterName"`
+	ManagementClusterName string `json:"managementClusterName"`
+	ProvisionerName       string `json:"provisionerName"`
+}
+
+// PolicyIssueSpec describes the violations of a policy by an object. The enforcement
+// action tells whether the object was denied or the violations only reported.
+type PolicyIssueSpec struct {
+	PolicyName        string             `json:"policyName"`
+	PolicyType        string             `json:"policyType"`
+	Recipe            string             `json:"recipe,omitempty"`
+	Target            *PolicyIssueTarget `json:"target"`
+	Message           string             `json:"message"`
+	EnforcementAction string             `json:"enforcementAction,omitempty"`
+	ViolationCount    int                `json:"violationCount,omitempty"`
+	LastObservedTime  string             `json:"lastObservedTime,omitempty"`
+}
+
+type PolicyIssue struct {
+	FullName *PolicyIssueFullName `json:"fullName"`
+	Meta     *MetaData            `json:"meta"`
+	Spec     *PolicyIssueSpec     `json:"spec"`
+}
+
+type PolicyIssueListResponse struct {
+	Issues     []PolicyIssue `json:"issues"`
+	TotalCount string        `json:"totalCount"`
+}
+
+// Lists the policy issues reported on the clusters of a cluster group, workspace or cluster,
+// following the pagination of the TMC API until every page has been read.
+func (c *Client) ListPolicyIssues(scope *Scope, query *Query) ([]PolicyIssue, error) {
+	params := url.Values{}
+
+	switch scope.Type {
+	case ClusterGroupScope:
+		params.Set("searchScope.clusterGroupName", scope.Name)
+	case WorkspaceScope:
+		params.Set("searchScope.workspaceName", scope.Name)
+	case ClusterScope:
+		params.Set("searchScope.clusterName", scope.Name)
+		params.Set("searchScope.managementClusterName", scope.ManagementClusterName)
+		params.Set("searchScope.provisionerName", scope.ProvisionerName)
+	default:
+		return nil, fmt.Errorf("policy issues can not be searched in a %s scope", scope.Type)
+	}
+
+	if q := query.String(); q != "" {
+		params.Set("query", q)
+	}
+	issues := make([]PolicyIssue, 0)
+
+	err := c.sendListRequest(fmt.Sprintf("%s/v1alpha1/policy/insights/issues", c.baseURL), params, func(req *http.Request) (int, string, error) {
+		res := PolicyIssueListResponse{}
+
+		if err := c.sendRequest(req, &res); err != nil {
+			return 0, "", err
+		}
+
+		issues = append(issues, res.Issues...)
+
+		return len(res.Issues), res.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 type Provisioner struct {
@@ -39,10 +40,8 @@ func (c *Client) GetProvisioner(mgmtClusterName, name string) (*Provisioner, err
 	return &res.Provisioner, nil
 }
 
-func (c *Client) GetAllProvisioners(mgmtClusterName string, labels map[string]interface{}) ([]Provisioner, error) {
-	queryString := buildLabelQuery(labels)
-
-	tmcURL := fmt.Sprintf("%s/v1alpha1/managementclusters/%s/provisioners?query=%s", c.baseURL, mgmtClusterName, queryString)
+func (c *Client) GetAllProvisioners(mgmtClusterName string, query *Query) ([]Provisioner, error) {
+	tmcURL := fmt.Sprintf("%s/v1alpha1/managementclusters/%s/provisioners?query=%s", c.baseURL, mgmtClusterName, url.QueryEscape(query.String()))
 
 	req, err := http.NewRequest("GET", tmcURL, nil)
 	if err != nil {
@@ -0,0 +1,23 @@
+package tanzuclient
+
+// Recipes of the namespace quota policies. The small, medium and large
+// recipes apply predefined quotas and take no input.
+const (
+	QuotaPolicySmallRecipe  = "small"
+	QuotaPolicyMediumRecipe = "medium"
+	QuotaPolicyLargeRecipe  = "large"
+	QuotaPolicyCustomRecipe = "custom"
+)
+
+// QuotaPolicyInput is the input of the custom recipe. Quantities use the Kubernetes
+// notation, e.g. 500m for CPU or 2Gi for memory and storage.
+type QuotaPolicyInput struct {
+	LimitsCpu                      string            `json:"limitsCpu,omitempty"`
+	LimitsMemory                   string            `json:"limitsMemory,omitempty"`
+	RequestsCpu                    string            `json:"requestsCpu,omitempty"`
+	RequestsMemory                 string            `json:"requestsMemory,omitempty"`
+	RequestsStorage                string            `json:"requestsStorage,omitempty"`
+	PersistentVolumeClaims         int               `json:"persistentvolumeclaims,omitempty"`
+	PersistentVolumeClaimsPerClass map[string]int    `json:"persistentvolumeclaimsPerClass,omitempty"`
+	RequestedStoragePerClass       map[string]string `json:"requestedStoragePerClass,omitempty"`
+}
@@ -0,0 +1,108 @@
+package tanzuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type ClusterRestoreSpec struct {
+	BackupName              string            `json:"backupName"`
+	IncludedNamespaces      []string          `json:"includedNamespaces,omitempty"`
+	ExcludedNamespaces      []string          `json:"excludedNamespaces,omitempty"`
+	IncludedResources       []string          `json:"includedResources,omitempty"`
+	ExcludedResources       []string          `json:"excludedResources,omitempty"`
+	NamespaceMapping        map[string]string `json:"namespaceMapping,omitempty"`
+	LabelSelector           *LabelSelector    `json:"labelSelector,omitempty"`
+	RestorePVs              bool              `json:"restorePvs"`
+	IncludeClusterResources bool              `json:"includeClusterResources"`
+}
+
+// The warnings and errors are counted by Velero while the backup is restored
+type ClusterRestoreStatus struct {
+	Phase    string `json:"phase,omitempty"`
+	Warnings int    `json:"warnings,omitempty"`
+	Errors   int    `json:"errors,omitempty"`
+}
+
+type TmcClusterRestore struct {
+	FullName *FullName             `json:"fullName"`
+	Meta     *MetaData             `json:"meta"`
+	Status   *ClusterRestoreStatus `json:"status"`
+	Spec     *ClusterRestoreSpec   `json:"spec"`
+}
+
+type TmcClusterRestoreResponse struct {
+	Restore TmcClusterRestore `json:"restore"`
+}
+
+func clusterRestoreURL(baseURL string, name string, mgmtClusterName string, clusterName string, provisionerName string) string {
+	params := url.Values{}
+	params.Set("fullName.managementClusterName", mgmtClusterName)
+	params.Set("fullName.provisionerName", provisionerName)
+
+	return fmt.Sprintf("%s/v1alpha1/clusters/%s/dataprotection/restores/%s?%s", baseURL, url.PathEscape(clusterName), url.PathEscape(name), params.Encode())
+}
+
+func (c *Client) GetClusterRestore(name string, mgmtClusterName string, clusterName string, provisionerName string) (*TmcClusterRestore, error) {
+	requestURL := clusterRestoreURL(c.baseURL, name, mgmtClusterName, clusterName, provisionerName)
+
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := TmcClusterRestoreResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Restore, nil
+}
+
+func (c *Client) CreateClusterRestore(restore *TmcClusterRestore) (*TmcClusterRestore, error) {
+	requestURL := fmt.Sprintf("%s/v1alpha1/clusters/%s/dataprotection/restores", c.baseURL, url.PathEscape(restore.FullName.ClusterName))
+
+	newRestoreObject := &TmcClusterRestoreResponse{
+		Restore: *restore,
+	}
+
+	json_data, err := json.Marshal(newRestoreObject) // returns []byte
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(json_data))
+	if err != nil {
+		return nil, err
+	}
+
+	res := TmcClusterRestoreResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res.Restore, nil
+}
+
+// Deletes the record of a restore, the restored resources are left in the cluster.
+func (c *Client) DeleteClusterRestore(name string, mgmtClusterName string, clusterName string, provisionerName string) error {
+	requestURL := clusterRestoreURL(c.baseURL, name, mgmtClusterName, clusterName, provisionerName)
+
+	req, err := http.NewRequest("DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res := TmcClusterRestoreResponse{}
+
+	if err := c.sendRequest(req, &res); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package tanzuclient
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Types of the objects of the TMC resource hierarchy that access policies
+// and policies can be attached to.
+const (
+	OrganizationScope = "organization"
+	ClusterGroupScope = "cluster_group"
+	WorkspaceScope    = "workspace"
+	ClusterScope      = "cluster"
+	NamespaceScope    = "namespace"
+)
+
+// Scope identifies the object of the resource hierarchy a policy is attached to.
+// Name is the name of the object itself, ClusterName is only used by namespaces,
+// and the management cluster and provisioner only by clusters and namespaces.
+type Scope struct {
+	Type                  string
+	Name                  string
+	ClusterName           string
+	ManagementClusterName string
+	ProvisionerName       string
+}
+
+// Builds the URL of the scope's object followed by the suffix, e.g. ":iam" or "/policies".
+func (s *Scope) requestURL(baseURL string, suffix string) (string, error) {
+	var path string
+	params := url.Values{}
+
+	switch s.Type {
+	case OrganizationScope:
+		path = "organization"
+	case ClusterGroupScope:
+		path = "clustergroups/" + url.PathEscape(s.Name)
+	case WorkspaceScope:
+		path = "workspaces/" + url.PathEscape(s.Name)
+	case ClusterScope:
+		path = "clusters/" + url.PathEscape(s.Name)
+		params.Set("fullName.managementClusterName", s.ManagementClusterName)
+		params.Set("fullName.provisionerName", s.ProvisionerName)
+	case NamespaceScope:
+		path = fmt.Sprintf("clusters/%s/namespaces/%s", url.PathEscape(s.ClusterName), url.PathEscape(s.Name))
+		params.Set("fullName.managementClusterName", s.ManagementClusterName)
+		params.Set("fullName.provisionerName", s.ProvisionerName)
+	default:
+		return "", fmt.Errorf("unknown scope type %q", s.Type)
+	}
+
+	requestURL := fmt.Sprintf("%s/v1alpha1/%s%s", baseURL, path, suffix)
+	if len(params) > 0 {
+		requestURL = fmt.Sprintf("%s?%s", requestURL, params.Encode())
+	}
+
+	return requestURL, nil
+}
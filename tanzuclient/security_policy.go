@@ -0,0 +1,55 @@
+package tanzuclient
+
+// Recipes of the security policies
+const (
+	SecurityPolicyBaselineRecipe = "baseline"
+	SecurityPolicyStrictRecipe   = "strict"
+	SecurityPolicyCustomRecipe   = "custom"
+)
+
+type PolicyRange struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// Rule restricting the user, group or fs group IDs containers can run as
+type RunAsRule struct {
+	Rule   string        `json:"rule"`
+	Ranges []PolicyRange `json:"ranges,omitempty"`
+}
+
+type LinuxCapabilities struct {
+	AllowedCapabilities      []string `json:"allowedCapabilities,omitempty"`
+	RequiredDropCapabilities []string `json:"requiredDropCapabilities,omitempty"`
+}
+
+type AllowedHostPath struct {
+	PathPrefix string `json:"pathPrefix"`
+	ReadOnly   bool   `json:"readOnly"`
+}
+
+type Sysctls struct {
+	ForbiddenSysctls []string `json:"forbiddenSysctls,omitempty"`
+}
+
+// SecurityPolicyInput is the input of every security policy recipe. The baseline and
+// strict recipes only accept the audit and native PSP settings, the other settings
+// are only set for the custom recipe.
+type SecurityPolicyInput struct {
+	Audit                     bool               `json:"audit"`
+	DisableNativePsp          bool               `json:"disableNativePsp"`
+	AllowPrivilegedContainers *bool              `json:"allowPrivilegedContainers,omitempty"`
+	AllowPrivilegeEscalation  *bool              `json:"allowPrivilegeEscalation,omitempty"`
+	AllowHostNamespaceSharing *bool              `json:"allowHostNamespaceSharing,omitempty"`
+	AllowHostNetwork          *bool              `json:"allowHostNetwork,omitempty"`
+	ReadOnlyRootFileSystem    *bool              `json:"readOnlyRootFileSystem,omitempty"`
+	AllowedHostPortRange      *PolicyRange       `json:"allowedHostPortRange,omitempty"`
+	AllowedVolumes            []string           `json:"allowedVolumes,omitempty"`
+	AllowedHostPaths          []AllowedHostPath  `json:"allowedHostPaths,omitempty"`
+	LinuxCapabilities         *LinuxCapabilities `json:"linuxCapabilities,omitempty"`
+	RunAsUser                 *RunAsRule         `json:"runAsUser,omitempty"`
+	RunAsGroup                *RunAsRule         `json:"runAsGroup,omitempty"`
+	SupplementalGroups        *RunAsRule         `json:"supplementalGroups,omitempty"`
+	FsGroup                   *RunAsRule         `json:"fsGroup,omitempty"`
+	Sysctls                   *Sysctls           `json:"sysctls,omitempty"`
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 type Workspace struct {
@@ -39,10 +40,8 @@ func (c *Client) GetWorkspace(name string) (*Workspace, error) {
 	return &res.Workspace, nil
 }
 
-func (c *Client) GetAllWorkspaces(labels map[string]interface{}) ([]Workspace, error) {
-	queryString := buildLabelQuery(labels)
-
-	tmcURL := fmt.Sprintf("%s/v1alpha1/workspaces?query=%s", c.baseURL, queryString)
+func (c *Client) GetAllWorkspaces(query *Query) ([]Workspace, error) {
+	tmcURL := fmt.Sprintf("%s/v1alpha1/workspaces?query=%s", c.baseURL, url.QueryEscape(query.String()))
 
 	req, err := http.NewRequest("GET", tmcURL, nil)
 	if err != nil {
@@ -0,0 +1,157 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsNodePools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsNodePoolsRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the cluster in which the nodepools are present",
+			},
+			"management_cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the management cluster used",
+			},
+			"provisioner_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the provisioner",
+			},
+			"labels": labelsSchema(),
+			"filter": filterSchema(),
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the Nodepools",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "UIDs of the Nodepools",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"nodepools": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Nodepools matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"worker_node_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsNodePoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	query, err := expandFilterQuery(d, func(g *tanzuclient.QueryGroup) {
+		g.MatchLabels(d.Get("labels").(map[string]interface{}))
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list nodepools",
+			Detail:   fmt.Sprintf("Error building the query: %s", err),
+		})
+		return diags
+	}
+
+	res, err := client.ListNodePools(d.Get("cluster_name").(string), d.Get("management_cluster").(string), d.Get("provisioner_name").(string), query)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list nodepools",
+			Detail:   fmt.Sprintf("Error listing nodepools of cluster %s: %s", d.Get("cluster_name"), err),
+		})
+		return diags
+	}
+
+	nodepoolNames := make([]interface{}, len(res))
+	nodepoolIds := make([]interface{}, len(res))
+	nodepools := make([]interface{}, len(res))
+
+	for i, nodepool := range res {
+		nodepoolNames[i] = nodepool.FullName.Name
+		nodepoolIds[i] = nodepool.Meta.UID
+
+		np := map[string]interface{}{
+			"id":          nodepool.Meta.UID,
+			"name":        nodepool.FullName.Name,
+			"description": nodepool.Meta.Description,
+		}
+		if nodepool.Spec != nil {
+			nodeCount, _ := strconv.Atoi(nodepool.Spec.WorkerNodeCount)
+			np["worker_node_count"] = nodeCount
+			np["availability_zone"] = nodepool.Spec.NodeTkgAws.AvailabilityZone
+			np["instance_type"] = nodepool.Spec.NodeTkgAws.InstanceType
+			np["version"] = nodepool.Spec.NodeTkgAws.Version
+		}
+		if nodepool.Status != nil {
+			np["status"] = nodepool.Status.Phase
+		}
+		nodepools[i] = np
+	}
+
+	if err := d.Set("names", nodepoolNames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", nodepoolIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("nodepools", nodepools); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return diags
+}
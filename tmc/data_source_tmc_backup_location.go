@@ -0,0 +1,151 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTmcBackupLocation() *schema.Resource {
+	locationSchema := backupLocationSchemaComputed()
+	locationSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Unique ID of the Tanzu Backup Location",
+	}
+	locationSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the Tanzu Backup Location",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceTmcBackupLocationRead,
+		Schema:      locationSchema,
+	}
+}
+
+func dataSourceTmcBackupLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	location, err := client.GetBackupLocation(name)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read backup location",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	for k, v := range flattenBackupLocation(location) {
+		if k == "id" || k == "name" {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read backup location",
+				Detail:   fmt.Sprintf("Error setting %s for resource %s: %s", k, name, err),
+			})
+			return diags
+		}
+	}
+
+	d.SetId(location.Meta.UID)
+
+	return diags
+}
+
+// backupLocationSchemaComputed returns the attributes describing a backup location, shared by the backup location data sources
+func backupLocationSchemaComputed() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"labels": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Type of the target the backups are stored in, one of tmc, s3, s3_compatible or azure_blob",
+		},
+		"credential_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the data protection credential granting access to the target",
+		},
+		"bucket": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the bucket, or of the container for Azure Blob targets",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Region of the bucket",
+		},
+		"s3_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "URL of the endpoint of an S3-compatible target",
+		},
+		"public_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Public URL of an S3-compatible target",
+		},
+		"s3_force_path_style": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the bucket of an S3-compatible target is addressed by path",
+		},
+		"ca_cert": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "PEM encoded CA certificate of an S3-compatible target",
+		},
+		"azure": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Storage account of an Azure Blob target",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"resource_group": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"storage_account": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"subscription_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"assigned_cluster_groups": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Names of the cluster groups whose clusters may store their backups in the location",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"resource_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
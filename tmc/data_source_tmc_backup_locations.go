@@ -0,0 +1,113 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTmcBackupLocations() *schema.Resource {
+	locationSchema := backupLocationSchemaComputed()
+	locationSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	locationSchema["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceTmcBackupLocationsRead,
+		Schema: map[string]*schema.Schema{
+			"assigned_cluster_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the Backup Locations assigned to this cluster group",
+			},
+			"filter": filterSchema(),
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the Backup Locations",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "UIDs of the Backup Locations",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"backup_locations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Backup Locations matching the filters",
+				Elem: &schema.Resource{
+					Schema: locationSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTmcBackupLocationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	query, err := expandFilterQuery(d, func(g *tanzuclient.QueryGroup) {})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list backup locations",
+			Detail:   fmt.Sprintf("Error building the query: %s", err),
+		})
+		return diags
+	}
+
+	res, err := client.ListBackupLocations(query)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list backup locations",
+			Detail:   fmt.Sprintf("Error listing backup locations: %s", err),
+		})
+		return diags
+	}
+
+	// The assigned groups are not supported by the query of the backup locations endpoint
+	clusterGroup := d.Get("assigned_cluster_group").(string)
+
+	locationNames := make([]interface{}, 0, len(res))
+	locationIds := make([]interface{}, 0, len(res))
+	locations := make([]interface{}, 0, len(res))
+
+	for i := range res {
+		l := flattenBackupLocation(&res[i])
+
+		if clusterGroup != "" && !containsString(l["assigned_cluster_groups"].([]string), clusterGroup) {
+			continue
+		}
+
+		locationNames = append(locationNames, l["name"])
+		locationIds = append(locationIds, l["id"])
+		locations = append(locations, l)
+	}
+
+	if err := d.Set("names", locationNames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", locationIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("backup_locations", locations); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return diags
+}
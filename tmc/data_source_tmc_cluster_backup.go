@@ -3,6 +3,7 @@ package tmc
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,7 +11,7 @@ import (
 )
 
 func dataSourceTmcClusterBackup() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		ReadContext: dataSourceTmcClusterBackupRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -139,6 +140,12 @@ func dataSourceTmcClusterBackup() *schema.Resource {
 			},
 		},
 	}
+
+	for k, v := range clusterBackupStatusSchema() {
+		r.Schema[k] = v
+	}
+
+	return r
 }
 
 func dataSourceTmcClusterBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -178,41 +185,104 @@ func dataSourceTmcClusterBackupRead(ctx context.Context, d *schema.ResourceData,
 		})
 		return diags
 	}
-	d.Set("status", backup.Status.Phase)
+	for k, v := range flattenClusterBackupStatus(backup.Status) {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse backup resource data",
+				Detail:   fmt.Sprintf("Error setting %s for resource %s: %s", k, d.Get("name"), err),
+			})
+			return diags
+		}
+	}
 
 	return diags
 }
 
-func flattenLabelSelector(labelSelector *tanzuclient.LabelSelector) []interface{} {
-	ls := make(map[string]interface{})
-
-	if labelSelector != nil {
-		if labelSelector.MatchLabels != nil {
-			ls["match_labels"] = labelSelector.MatchLabels
-
-		}
-		ls["match_expressions"] = flattenMatchExpressions(labelSelector.MatchExpressions)
+// clusterBackupStatusSchema returns the attributes reporting the progress and contents of a backup
+func clusterBackupStatusSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"start_timestamp": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time the backup started, in RFC 3339 format",
+		},
+		"completion_timestamp": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time the backup completed, in RFC 3339 format",
+		},
+		"expiration": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time the backup is garbage collected after, in RFC 3339 format",
+		},
+		"items_backed_up": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of the items backed up so far",
+		},
+		"total_items": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of the items to be backed up",
+		},
+		"volume_snapshots_attempted": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of the volume snapshots attempted by the backup",
+		},
+		"volume_snapshots_completed": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of the volume snapshots completed by the backup",
+		},
+		"errors": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of the errors encountered by the backup",
+		},
+		"warnings": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of the warnings raised by the backup",
+		},
+		"size_bytes": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Size of the backup in bytes",
+		},
 	}
-
-	return []interface{}{ls}
 }
 
-func flattenMatchExpressions(matchExpressions []tanzuclient.MatchExpressions) []interface{} {
-	if matchExpressions != nil {
-		mes := make([]interface{}, len(matchExpressions))
-
-		for i, matchExpression := range matchExpressions {
-			me := make(map[string]interface{})
+func flattenClusterBackupStatus(status *tanzuclient.ClusterBackupStatus) map[string]interface{} {
+	if status == nil {
+		status = &tanzuclient.ClusterBackupStatus{}
+	}
 
-			me["key"] = matchExpression.Key
-			me["operator"] = matchExpression.Operator
-			me["values"] = matchExpression.Values
+	s := map[string]interface{}{
+		"status":                     status.Phase,
+		"start_timestamp":            status.StartTimestamp,
+		"completion_timestamp":       status.CompletionTimestamp,
+		"expiration":                 status.Expiration,
+		"items_backed_up":            0,
+		"total_items":                0,
+		"volume_snapshots_attempted": status.VolumeSnapshotsAttempted,
+		"volume_snapshots_completed": status.VolumeSnapshotsCompleted,
+		"errors":                     status.Errors,
+		"warnings":                   status.Warnings,
+		"size_bytes":                 0,
+	}
 
-			mes[i] = me
-		}
+	if status.Progress != nil {
+		s["items_backed_up"] = status.Progress.ItemsBackedUp
+		s["total_items"] = status.Progress.TotalItems
+	}
 
-		return mes
+	// The size is not reported until the backup has been uploaded
+	if size, err := strconv.ParseInt(status.TotalSize, 10, 64); err == nil {
+		s["size_bytes"] = int(size)
 	}
 
-	return make([]interface{}, 0)
+	return s
 }
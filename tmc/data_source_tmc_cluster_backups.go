@@ -0,0 +1,142 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTmcClusterBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTmcClusterBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Tanzu Cluster",
+			},
+			"management_cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Tanzu Management Cluster",
+			},
+			"provisioner_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Tanzu Management Cluster Provisioner",
+			},
+			"labels": labelsSchema(),
+			"filter": filterSchema(),
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the Tanzu Cluster Backups",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "UIDs of the Tanzu Cluster Backups",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"backups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Tanzu Cluster Backups matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retention_period": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": labelsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTmcClusterBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	query, err := expandFilterQuery(d, func(g *tanzuclient.QueryGroup) {
+		g.MatchLabels(d.Get("labels").(map[string]interface{}))
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list cluster backups",
+			Detail:   fmt.Sprintf("Error building the query: %s", err),
+		})
+		return diags
+	}
+
+	res, err := client.ListClusterBackups(d.Get("management_cluster_name").(string), d.Get("cluster_name").(string), d.Get("provisioner_name").(string), query)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list cluster backups",
+			Detail:   fmt.Sprintf("Error listing backups of cluster %s: %s", d.Get("cluster_name"), err),
+		})
+		return diags
+	}
+
+	backupNames := make([]interface{}, len(res))
+	backupIds := make([]interface{}, len(res))
+	backups := make([]interface{}, len(res))
+
+	for i, backup := range res {
+		backupNames[i] = backup.FullName.Name
+		backupIds[i] = backup.Meta.UID
+
+		b := map[string]interface{}{
+			"id":     backup.Meta.UID,
+			"name":   backup.FullName.Name,
+			"labels": backup.Meta.Labels,
+		}
+		if backup.Spec != nil {
+			b["storage_location"] = backup.Spec.StorageLocation
+			b["retention_period"] = backup.Spec.TTL
+		}
+		if backup.Status != nil {
+			b["status"] = backup.Status.Phase
+		}
+		backups[i] = b
+	}
+
+	if err := d.Set("names", backupNames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", backupIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("backups", backups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return diags
+}
@@ -15,6 +15,7 @@ func dataSourceClusterGroups() *schema.Resource {
 		ReadContext: dataSourceClusterGroupsRead,
 		Schema: map[string]*schema.Schema{
 			"labels": labelsSchema(),
+			"filter": filterSchema(),
 			"ids": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -38,7 +39,19 @@ func dataSourceClusterGroupsRead(ctx context.Context, d *schema.ResourceData, me
 
 	labels := d.Get("labels").(map[string]interface{})
 
-	res, err := client.GetAllClusterGroups(labels)
+	query, err := expandFilterQuery(d, func(g *tanzuclient.QueryGroup) {
+		g.MatchLabels(labels)
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster groups",
+			Detail:   fmt.Sprintf("Error building the query: %s", err),
+		})
+		return diags
+	}
+
+	res, err := client.GetAllClusterGroups(query)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,138 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTmcClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTmcClusterKubeconfigRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the Cluster",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Cluster",
+			},
+			"management_cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the management cluster used",
+			},
+			"provisioner_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the provisioner",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "admin",
+				Description:  "Type of the kubeconfig, either admin or pinniped",
+				ValidateFunc: validation.StringInSlice([]string{"admin", "pinniped"}, false),
+			},
+			"kubeconfig_raw": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Raw YAML of the kubeconfig",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Address of the Kubernetes API server",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded CA certificate of the Kubernetes API server",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token used to authenticate to the Kubernetes API server",
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded client certificate used to authenticate to the Kubernetes API server",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded client key used to authenticate to the Kubernetes API server",
+			},
+		},
+	}
+}
+
+func dataSourceTmcClusterKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	clusterName := d.Get("name").(string)
+	managementClusterName := d.Get("management_cluster").(string)
+	provisionerName := d.Get("provisioner_name").(string)
+
+	var diags diag.Diagnostics
+
+	cluster, err := client.GetCluster(clusterName, managementClusterName, provisionerName)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster kubeconfig",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	var kubeconfig string
+	if d.Get("type").(string) == "pinniped" {
+		kubeconfig, err = client.GetClusterPinnipedKubeconfig(clusterName, managementClusterName, provisionerName)
+	} else {
+		kubeconfig, err = client.GetClusterAdminKubeconfig(clusterName, managementClusterName, provisionerName)
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster kubeconfig",
+			Detail:   fmt.Sprintf("Error getting kubeconfig for resource %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	credentials, err := parseKubeconfig(kubeconfig)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster kubeconfig",
+			Detail:   fmt.Sprintf("Error parsing kubeconfig for resource %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	d.Set("kubeconfig_raw", kubeconfig)
+	d.Set("host", credentials.Host)
+	d.Set("cluster_ca_certificate", credentials.ClusterCACertificate)
+	d.Set("token", credentials.Token)
+	d.Set("client_certificate", credentials.ClientCertificate)
+	d.Set("client_key", credentials.ClientKey)
+
+	d.SetId(cluster.Meta.UID)
+
+	return diags
+}
@@ -0,0 +1,323 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTmcClusterStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTmcClusterStatusRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the Cluster",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Cluster",
+			},
+			"management_cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the management cluster used",
+			},
+			"provisioner_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the provisioner",
+			},
+			"phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Phase of the Cluster",
+			},
+			"health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health of the Cluster as reported by the cluster agent",
+			},
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the Cluster is HEALTHY",
+			},
+			"health_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Message describing the health of the Cluster",
+			},
+			"kubernetes_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the Kubernetes API server",
+			},
+			"agent_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the cluster agent",
+			},
+			"node_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of nodes in the Cluster",
+			},
+			"worker_node_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of worker nodes in the Cluster",
+			},
+			"allocatable_cpu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Allocatable CPU of the Cluster in millicores",
+			},
+			"requested_cpu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "CPU requested by the workloads of the Cluster in millicores",
+			},
+			"allocatable_memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Allocatable memory of the Cluster in MiB",
+			},
+			"requested_memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Memory requested by the workloads of the Cluster in MiB",
+			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Conditions reported for the Cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_transition_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"components": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Health of the control plane components of the Cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"extensions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "TMC extensions installed on the Cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"phase": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTmcClusterStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	clusterName := d.Get("name").(string)
+	managementClusterName := d.Get("management_cluster").(string)
+	provisionerName := d.Get("provisioner_name").(string)
+
+	var diags diag.Diagnostics
+
+	cluster, err := client.GetCluster(clusterName, managementClusterName, provisionerName)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster status",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	extensions, err := client.ListClusterExtensions(clusterName, managementClusterName, provisionerName)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster status",
+			Detail:   fmt.Sprintf("Error listing extensions of resource %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	status := cluster.Status
+	if status == nil {
+		status = &tanzuclient.ClusterStatus{}
+	}
+
+	d.Set("phase", status.Phase)
+	d.Set("health", status.Health)
+	d.Set("healthy", status.Health == "HEALTHY")
+	d.Set("kubernetes_version", status.KubeServerVersion)
+	d.Set("agent_version", status.AgentVersion)
+	d.Set("node_count", status.NodeCount)
+	d.Set("worker_node_count", status.WorkerNodeCount)
+
+	if status.AllocatedCpu != nil {
+		d.Set("allocatable_cpu", status.AllocatedCpu.Allocatable)
+		d.Set("requested_cpu", status.AllocatedCpu.Requested)
+	}
+	if status.AllocatedMemory != nil {
+		d.Set("allocatable_memory", status.AllocatedMemory.Allocatable)
+		d.Set("requested_memory", status.AllocatedMemory.Requested)
+	}
+
+	components := make([]interface{}, 0)
+	if details := status.HealthDetails; details != nil {
+		d.Set("health_message", details.Message)
+		if details.ControllerManagerHealth != nil {
+			components = append(components, flattenComponentHealth("controller-manager", details.ControllerManagerHealth))
+		}
+		if details.SchedulerHealth != nil {
+			components = append(components, flattenComponentHealth("scheduler", details.SchedulerHealth))
+		}
+		for i := range details.ComponentHealth {
+			components = append(components, flattenComponentHealth(details.ComponentHealth[i].Name, &details.ComponentHealth[i]))
+		}
+	}
+
+	if err := d.Set("components", components); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster status",
+			Detail:   fmt.Sprintf("Error getting component health for resource %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	if err := d.Set("conditions", flattenClusterConditions(status.Conditions)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster status",
+			Detail:   fmt.Sprintf("Error getting conditions for resource %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	if err := d.Set("extensions", flattenClusterExtensions(extensions)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster status",
+			Detail:   fmt.Sprintf("Error getting extensions for resource %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	d.SetId(cluster.Meta.UID)
+
+	return diags
+}
+
+func flattenComponentHealth(name string, health *tanzuclient.ComponentHealth) map[string]interface{} {
+	return map[string]interface{}{
+		"name":   name,
+		"health": health.Health,
+	}
+}
+
+// Conditions are keyed by their type, they are sorted to keep the list stable between reads.
+func flattenClusterConditions(conditions map[string]tanzuclient.ClusterCondition) []interface{} {
+	keys := make([]string, 0, len(conditions))
+	for k := range conditions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		condition := conditions[k]
+		conditionType := condition.Type
+		if conditionType == "" {
+			conditionType = k
+		}
+		out = append(out, map[string]interface{}{
+			"type":                 conditionType,
+			"status":               condition.Status,
+			"severity":             condition.Severity,
+			"reason":               condition.Reason,
+			"message":              condition.Message,
+			"last_transition_time": condition.LastTransitionTime,
+		})
+	}
+
+	return out
+}
+
+func flattenClusterExtensions(extensions []tanzuclient.ClusterExtension) []interface{} {
+	out := make([]interface{}, 0, len(extensions))
+	for _, extension := range extensions {
+		if extension.FullName == nil {
+			continue
+		}
+		out = append(out, map[string]interface{}{
+			"name":    extension.FullName.Name,
+			"version": extension.Spec.Version,
+			"phase":   extension.Status.Phase,
+			"health":  extension.Status.Health,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,183 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTmcClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTmcClustersRead,
+		Schema: map[string]*schema.Schema{
+			"management_cluster": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the management cluster of the Clusters",
+			},
+			"provisioner_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the provisioner of the Clusters",
+			},
+			"cluster_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the cluster group of the Clusters",
+			},
+			"kubernetes_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Kubernetes version of the Clusters",
+			},
+			"health": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Health of the Clusters",
+				ValidateFunc: validation.StringInSlice([]string{"HEALTHY", "UNHEALTHY", "DISCONNECTED", "UNKNOWN"}, false),
+			},
+			"labels": labelsSchema(),
+			"filter": filterSchema(),
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the Clusters",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "UIDs of the Clusters",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"clusters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Clusters matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"management_cluster": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provisioner_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kubernetes_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"phase": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": labelsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTmcClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope := &tanzuclient.ClusterSearchScope{
+		ManagementClusterName: d.Get("management_cluster").(string),
+		ProvisionerName:       d.Get("provisioner_name").(string),
+	}
+
+	query, err := expandFilterQuery(d, func(g *tanzuclient.QueryGroup) {
+		if v := d.Get("cluster_group").(string); v != "" {
+			g.Equals("spec.clusterGroupName", v)
+		}
+		if v := d.Get("kubernetes_version").(string); v != "" {
+			g.Equals("status.kubeServerVersion", v)
+		}
+		if v := d.Get("health").(string); v != "" {
+			g.Equals("status.health", v)
+		}
+		g.MatchLabels(d.Get("labels").(map[string]interface{}))
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list clusters",
+			Detail:   fmt.Sprintf("Error building the query: %s", err),
+		})
+		return diags
+	}
+
+	res, err := client.ListClusters(scope, query)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list clusters",
+			Detail:   fmt.Sprintf("Error listing clusters: %s", err),
+		})
+		return diags
+	}
+
+	clusterNames := make([]interface{}, len(res))
+	clusterIds := make([]interface{}, len(res))
+	clusters := make([]interface{}, len(res))
+
+	for i, cluster := range res {
+		clusterNames[i] = cluster.FullName.Name
+		clusterIds[i] = cluster.Meta.UID
+
+		c := map[string]interface{}{
+			"id":                 cluster.Meta.UID,
+			"name":               cluster.FullName.Name,
+			"management_cluster": cluster.FullName.ManagementClusterName,
+			"provisioner_name":   cluster.FullName.ProvisionerName,
+			"labels":             cluster.Meta.Labels,
+		}
+		if cluster.Spec != nil {
+			c["cluster_group"] = cluster.Spec.ClusterGroupName
+		}
+		if cluster.Status != nil {
+			c["kubernetes_version"] = cluster.Status.KubeServerVersion
+			c["phase"] = cluster.Status.Phase
+			c["health"] = cluster.Status.Health
+		}
+		clusters[i] = c
+	}
+
+	if err := d.Set("names", clusterNames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", clusterIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("clusters", clusters); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return diags
+}
@@ -0,0 +1,169 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTmcEffectivePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTmcEffectivePermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"subject_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the identity",
+			},
+			"subject_kind": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Kind of the identity, one of USER, GROUP or SERVICEACCOUNT",
+				ValidateFunc: validation.StringInSlice(subjectKinds, false),
+			},
+			"cluster_group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Name of the cluster group to report the permissions on",
+				ExactlyOneOf: []string{"cluster_group", "workspace"},
+			},
+			"workspace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Name of the workspace to report the permissions on",
+				ExactlyOneOf: []string{"cluster_group", "workspace"},
+			},
+			"bindings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Role bindings of the identity which apply to the cluster group or workspace",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the Roles bound to the identity",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "TMC permissions granted to the identity",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// Effective permissions are computed from the role bindings of the cluster group or workspace
+// and of the organization, which they inherit. Only the bindings of the identity itself are
+// taken into account, the membership of users in groups is not known to TMC.
+func dataSourceTmcEffectivePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	subject := &tanzuclient.Subject{
+		Name: d.Get("subject_name").(string),
+		Kind: d.Get("subject_kind").(string),
+	}
+
+	target := &tanzuclient.Scope{Type: tanzuclient.ClusterGroupScope, Name: d.Get("cluster_group").(string)}
+	if workspace := d.Get("workspace").(string); workspace != "" {
+		target = &tanzuclient.Scope{Type: tanzuclient.WorkspaceScope, Name: workspace}
+	}
+
+	scopes := []*tanzuclient.Scope{
+		{Type: tanzuclient.OrganizationScope},
+		target,
+	}
+
+	bindings := make([]interface{}, 0)
+	roleNames := make([]string, 0)
+	seenRoles := map[string]bool{}
+
+	for _, scope := range scopes {
+		policy, err := client.GetAccessPolicy(scope)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read effective permissions",
+				Detail:   fmt.Sprintf("Error reading IAM policy of %s: %s", scopeID(scope), err),
+			})
+			return diags
+		}
+
+		for _, binding := range policy.RoleBindings {
+			if !bindsSubject(binding, subject) {
+				continue
+			}
+			bindings = append(bindings, map[string]interface{}{
+				"role":  binding.Role,
+				"scope": scopeID(scope),
+			})
+			if !seenRoles[binding.Role] {
+				seenRoles[binding.Role] = true
+				roleNames = append(roleNames, binding.Role)
+			}
+		}
+	}
+
+	sort.Strings(roleNames)
+
+	roles, err := client.ListRoles(nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read effective permissions",
+			Detail:   fmt.Sprintf("Error listing roles: %s", err),
+		})
+		return diags
+	}
+
+	permissions := make([]string, 0)
+	seenPermissions := map[string]bool{}
+
+	for _, role := range roles {
+		if role.FullName == nil || role.Spec == nil || !seenRoles[role.FullName.Name] {
+			continue
+		}
+		for _, permission := range role.Spec.TanzuPermissions {
+			if !seenPermissions[permission] {
+				seenPermissions[permission] = true
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+
+	sort.Strings(permissions)
+
+	if err := d.Set("bindings", bindings); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("roles", roleNames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("permissions", permissions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", scopeID(target), subject.Kind, subject.Name))
+
+	return diags
+}
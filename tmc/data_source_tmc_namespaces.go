@@ -0,0 +1,143 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTmcNamespaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTmcNamespacesRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the cluster in which the namespaces are present",
+			},
+			"management_cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the management cluster used",
+			},
+			"provisioner_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the provisioner",
+			},
+			"workspace_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the workspace the namespaces belong to",
+			},
+			"labels": labelsSchema(),
+			"filter": filterSchema(),
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the Namespaces",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "UIDs of the Namespaces",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"namespaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Namespaces matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"workspace_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": labelsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTmcNamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	query, err := expandFilterQuery(d, func(g *tanzuclient.QueryGroup) {
+		if v := d.Get("workspace_name").(string); v != "" {
+			g.Equals("spec.workspaceName", v)
+		}
+		g.MatchLabels(d.Get("labels").(map[string]interface{}))
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list namespaces",
+			Detail:   fmt.Sprintf("Error building the query: %s", err),
+		})
+		return diags
+	}
+
+	res, err := client.ListNamespaces(d.Get("cluster_name").(string), d.Get("management_cluster").(string), d.Get("provisioner_name").(string), query)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list namespaces",
+			Detail:   fmt.Sprintf("Error listing namespaces of cluster %s: %s", d.Get("cluster_name"), err),
+		})
+		return diags
+	}
+
+	namespaceNames := make([]interface{}, len(res))
+	namespaceIds := make([]interface{}, len(res))
+	namespaces := make([]interface{}, len(res))
+
+	for i, namespace := range res {
+		namespaceNames[i] = namespace.FullName.Name
+		namespaceIds[i] = namespace.Meta.UID
+
+		ns := map[string]interface{}{
+			"id":          namespace.Meta.UID,
+			"name":        namespace.FullName.Name,
+			"description": namespace.Meta.Description,
+			"labels":      namespace.Meta.Labels,
+		}
+		if namespace.Spec != nil {
+			ns["workspace_name"] = namespace.Spec.WorkspaceName
+		}
+		namespaces[i] = ns
+	}
+
+	if err := d.Set("names", namespaceNames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", namespaceIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("namespaces", namespaces); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return diags
+}
@@ -0,0 +1,153 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Number of rejected workloads listed in the warning, the others are only counted
+const policyDryRunWarningLimit = 20
+
+// The dry run of a policy is read from the policy insights. The policy is attached in dry run
+// or audit mode first, TMC then reports its violations as issues without rejecting the
+// workloads, and those are the workloads it would reject once enforced. TMC has no API
+// evaluating a policy that is not attached.
+func dataSourceTmcPolicyDryRun() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTmcPolicyDryRunRead,
+		Schema: map[string]*schema.Schema{
+			"scope": scopeSchema(policyInsightsScopes...),
+			"policy_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the policy attached in dry run or audit mode",
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Type of the policy, when policies of several types share its name",
+				ValidateFunc: validation.StringInSlice(policyTypes, false),
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report the workloads of this namespace",
+			},
+			"fail_on_rejection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Report the rejected workloads as an error instead of a warning",
+			},
+			"rejected_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of workloads the policy would reject once enforced",
+			},
+			"rejected_workloads": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Workloads the policy would reject once enforced, as cluster/namespace/kind/name",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"issues": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Issues reported for the policy",
+				Elem:        policyIssueSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceTmcPolicyDryRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyName := d.Get("policy_name").(string)
+
+	query := tanzuclient.NewQuery()
+	g := query.Group()
+	g.Equals("spec.policyName", policyName)
+	if policyType := d.Get("policy_type").(string); policyType != "" {
+		g.Equals("spec.policyType", policyType)
+	}
+	if namespace := d.Get("namespace").(string); namespace != "" {
+		g.Equals("spec.target.namespace", namespace)
+	}
+
+	res, err := client.ListPolicyIssues(scope, query)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list policy issues",
+			Detail:   fmt.Sprintf("Error listing the issues of policy %s in %s: %s", policyName, scopeID(scope), err),
+		})
+		return diags
+	}
+
+	issues := make([]interface{}, 0, len(res))
+	rejected := make([]string, 0)
+	messages := make([]string, 0)
+	seen := map[string]bool{}
+
+	for _, issue := range res {
+		if issue.Spec == nil {
+			continue
+		}
+		i := flattenPolicyIssue(&issue)
+		issues = append(issues, i)
+
+		// A workload violating several rules of the policy is reported by several issues
+		workload := strings.Join([]string{i["cluster"].(string), i["namespace"].(string), i["kind"].(string), i["resource_name"].(string)}, "/")
+		if seen[workload] {
+			continue
+		}
+		seen[workload] = true
+
+		rejected = append(rejected, workload)
+		if len(messages) < policyDryRunWarningLimit {
+			messages = append(messages, fmt.Sprintf("%s: %s", workload, i["message"]))
+		}
+	}
+
+	d.Set("rejected_count", len(rejected))
+	if err := d.Set("rejected_workloads", rejected); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("issues", issues); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(rejected) > 0 {
+		if len(rejected) > len(messages) {
+			messages = append(messages, fmt.Sprintf("and %d more", len(rejected)-len(messages)))
+		}
+
+		severity := diag.Warning
+		if d.Get("fail_on_rejection").(bool) {
+			severity = diag.Error
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("Policy %s would reject %d workloads in %s once enforced", policyName, len(rejected), scopeID(scope)),
+			Detail:   strings.Join(messages, "\n"),
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return diags
+}
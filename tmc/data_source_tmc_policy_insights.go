@@ -0,0 +1,231 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Scopes the policy issues can be searched in
+var policyInsightsScopes = []string{
+	tanzuclient.ClusterGroupScope,
+	tanzuclient.WorkspaceScope,
+	tanzuclient.ClusterScope,
+}
+
+var policyTypes = []string{
+	tanzuclient.SecurityPolicyType,
+	tanzuclient.ImagePolicyType,
+	tanzuclient.NetworkPolicyType,
+	tanzuclient.QuotaPolicyType,
+	tanzuclient.CustomPolicyType,
+}
+
+func dataSourceTmcPolicyInsights() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTmcPolicyInsightsRead,
+		Schema: map[string]*schema.Schema{
+			"scope": scopeSchema(policyInsightsScopes...),
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only report the issues of the policies of this type",
+				ValidateFunc: validation.StringInSlice(policyTypes, false),
+			},
+			"policy_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report the issues of the policy with this name",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report the issues of the objects of this namespace",
+			},
+			"violation_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of violations of the reported issues",
+			},
+			"namespaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Namespaces of the objects violating a policy",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"issues": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Policy issues reported in the scope",
+				Elem:        policyIssueSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceTmcPolicyInsightsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	query := tanzuclient.NewQuery()
+	g := query.Group()
+	if policyType := d.Get("policy_type").(string); policyType != "" {
+		g.Equals("spec.policyType", policyType)
+	}
+	if policyName := d.Get("policy_name").(string); policyName != "" {
+		g.Equals("spec.policyName", policyName)
+	}
+	if namespace := d.Get("namespace").(string); namespace != "" {
+		g.Equals("spec.target.namespace", namespace)
+	}
+
+	res, err := client.ListPolicyIssues(scope, query)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list policy issues",
+			Detail:   fmt.Sprintf("Error listing the policy issues of %s: %s", scopeID(scope), err),
+		})
+		return diags
+	}
+
+	violationCount := 0
+	namespaces := make([]string, 0)
+	seen := map[string]bool{}
+	issues := make([]interface{}, 0, len(res))
+
+	for _, issue := range res {
+		if issue.Spec == nil {
+			continue
+		}
+		i := flattenPolicyIssue(&issue)
+
+		violationCount += i["violation_count"].(int)
+		if namespace := i["namespace"].(string); namespace != "" && !seen[namespace] {
+			seen[namespace] = true
+			namespaces = append(namespaces, namespace)
+		}
+		issues = append(issues, i)
+	}
+	sort.Strings(namespaces)
+
+	d.Set("violation_count", violationCount)
+	if err := d.Set("namespaces", namespaces); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("issues", issues); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return diags
+}
+
+// policyIssueSchema returns the schema of the policy issues reported by TMC
+func policyIssueSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"policy_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"management_cluster": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioner_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"api_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enforcement_action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"violation_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_observed_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// An issue always stands for at least one violation, TMC omits the count of single violations
+func flattenPolicyIssue(issue *tanzuclient.PolicyIssue) map[string]interface{} {
+	i := map[string]interface{}{
+		"policy_name":        issue.Spec.PolicyName,
+		"policy_type":        issue.Spec.PolicyType,
+		"message":            issue.Spec.Message,
+		"enforcement_action": issue.Spec.EnforcementAction,
+		"violation_count":    issue.Spec.ViolationCount,
+		"last_observed_time": issue.Spec.LastObservedTime,
+		"cluster":            "",
+		"management_cluster": "",
+		"provisioner_name":   "",
+		"api_version":        "",
+		"kind":               "",
+		"namespace":          "",
+		"resource_name":      "",
+	}
+	if issue.Spec.ViolationCount < 1 {
+		i["violation_count"] = 1
+	}
+
+	if issue.FullName != nil {
+		i["cluster"] = issue.FullName.ClusterName
+		i["management_cluster"] = issue.FullName.ManagementClusterName
+		i["provisioner_name"] = issue.FullName.ProvisionerName
+	}
+
+	if target := issue.Spec.Target; target != nil {
+		i["api_version"] = target.ApiVersion
+		i["kind"] = target.Kind
+		i["namespace"] = target.Namespace
+		i["resource_name"] = target.Name
+	}
+
+	return i
+}
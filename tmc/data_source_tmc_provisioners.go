@@ -31,6 +31,7 @@ func dataSourceTmcProvisioners() *schema.Resource {
 				Description: "Management Cluster Name of the Tanzu Provisioners",
 			},
 			"labels": labelsSchema(),
+			"filter": filterSchema(),
 		},
 	}
 }
@@ -44,7 +45,14 @@ func dataSourceTmcProvisionersRead(ctx context.Context, d *schema.ResourceData,
 	labels := d.Get("labels").(map[string]interface{})
 	mgmtClusterName := d.Get("management_cluster_name").(string)
 
-	res, err := client.GetAllProvisioners(mgmtClusterName, labels)
+	query, err := expandFilterQuery(d, func(g *tanzuclient.QueryGroup) {
+		g.MatchLabels(labels)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.GetAllProvisioners(mgmtClusterName, query)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,163 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTmcRole() *schema.Resource {
+	roleSchema := roleSchemaComputed()
+	roleSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Unique ID of the Role",
+	}
+	roleSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the Role",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceTmcRoleRead,
+		Schema:      roleSchema,
+	}
+}
+
+func dataSourceTmcRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	roleName := d.Get("name").(string)
+
+	role, err := client.GetRole(roleName)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read role",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", roleName, err),
+		})
+		return diags
+	}
+
+	for k, v := range flattenRole(role) {
+		if k == "id" || k == "name" {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read role",
+				Detail:   fmt.Sprintf("Error setting %s for resource %s: %s", k, roleName, err),
+			})
+			return diags
+		}
+	}
+
+	d.SetId(role.Meta.UID)
+
+	return diags
+}
+
+// roleSchemaComputed returns the attributes describing a role, shared by the role data sources
+func roleSchemaComputed() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of the Role",
+		},
+		"built_in": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the Role is provided by TMC, as opposed to a custom Role",
+		},
+		"deprecated": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the Role is deprecated",
+		},
+		"resource_types": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Types of the objects the Role can be bound on, e.g. CLUSTER_GROUP or NAMESPACE",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"permissions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "TMC permissions granted by the Role",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"rules": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Kubernetes RBAC rules granted by the Role on the clusters it applies to",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"api_groups": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"resources": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"resource_names": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"verbs": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenRole(role *tanzuclient.Role) map[string]interface{} {
+	r := map[string]interface{}{
+		"resource_types": []string{},
+		"permissions":    []string{},
+		"rules":          []interface{}{},
+	}
+
+	if role.FullName != nil {
+		r["name"] = role.FullName.Name
+	}
+	if role.Meta != nil {
+		r["id"] = role.Meta.UID
+		r["description"] = role.Meta.Description
+	}
+
+	if spec := role.Spec; spec != nil {
+		r["built_in"] = spec.IsInbuilt
+		r["deprecated"] = spec.IsDeprecated
+		r["resource_types"] = spec.Resources
+		r["permissions"] = spec.TanzuPermissions
+
+		rules := make([]interface{}, 0, len(spec.Rules))
+		for _, rule := range spec.Rules {
+			rules = append(rules, map[string]interface{}{
+				"api_groups":     rule.ApiGroups,
+				"resources":      rule.Resources,
+				"resource_names": rule.ResourceNames,
+				"verbs":          rule.Verbs,
+			})
+		}
+		r["rules"] = rules
+	}
+
+	return r
+}
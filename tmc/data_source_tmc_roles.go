@@ -0,0 +1,142 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Types of the objects of the resource hierarchy roles can be bound on
+var roleResourceTypes = []string{"ORGANIZATION", "CLUSTER_GROUP", "CLUSTER", "WORKSPACE", "NAMESPACE"}
+
+func dataSourceTmcRoles() *schema.Resource {
+	roleSchema := roleSchemaComputed()
+	roleSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	roleSchema["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceTmcRolesRead,
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Type of the objects the Roles can be bound on, one of ORGANIZATION, CLUSTER_GROUP, CLUSTER, WORKSPACE or NAMESPACE",
+				ValidateFunc: validation.StringInSlice(roleResourceTypes, false),
+			},
+			"built_in": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the Roles provided by TMC when true, or only the custom Roles when false",
+			},
+			"include_deprecated": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether deprecated Roles are listed",
+			},
+			"filter": filterSchema(),
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the Roles",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "UIDs of the Roles",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Roles matching the filters",
+				Elem: &schema.Resource{
+					Schema: roleSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTmcRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	query, err := expandFilterQuery(d, func(g *tanzuclient.QueryGroup) {})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list roles",
+			Detail:   fmt.Sprintf("Error building the query: %s", err),
+		})
+		return diags
+	}
+
+	res, err := client.ListRoles(query)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list roles",
+			Detail:   fmt.Sprintf("Error listing roles: %s", err),
+		})
+		return diags
+	}
+
+	// The remaining filters are not supported by the query of the roles endpoint
+	resourceType := d.Get("resource_type").(string)
+	builtIn, filterBuiltIn := d.GetOkExists("built_in")
+	includeDeprecated := d.Get("include_deprecated").(bool)
+
+	roleNames := make([]interface{}, 0, len(res))
+	roleIds := make([]interface{}, 0, len(res))
+	roles := make([]interface{}, 0, len(res))
+
+	for i := range res {
+		role := &res[i]
+		spec := role.Spec
+		if spec == nil {
+			spec = &tanzuclient.RoleSpec{}
+		}
+
+		if resourceType != "" && !containsString(spec.Resources, resourceType) {
+			continue
+		}
+		if filterBuiltIn && spec.IsInbuilt != builtIn.(bool) {
+			continue
+		}
+		if spec.IsDeprecated && !includeDeprecated {
+			continue
+		}
+
+		r := flattenRole(role)
+		roleNames = append(roleNames, r["name"])
+		roleIds = append(roleIds, r["id"])
+		roles = append(roles, r)
+	}
+
+	if err := d.Set("names", roleNames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", roleIds); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("roles", roles); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return diags
+}
@@ -26,6 +26,7 @@ func dataSourceTmcWorkspaces() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"labels": labelsSchema(),
+			"filter": filterSchema(),
 		},
 	}
 }
@@ -38,7 +39,14 @@ func dataSourceTmcWorkspacesRead(ctx context.Context, d *schema.ResourceData, me
 
 	labels := d.Get("labels").(map[string]interface{})
 
-	res, err := client.GetAllWorkspaces(labels)
+	query, err := expandFilterQuery(d, func(g *tanzuclient.QueryGroup) {
+		g.MatchLabels(labels)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.GetAllWorkspaces(query)
 	if err != nil {
 		return diag.FromErr(err)
 	}
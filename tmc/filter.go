@@ -0,0 +1,88 @@
+package tmc
+
+import (
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// filterSchema returns the schema of the filter blocks of the plural data sources.
+// Each block is a set of conditions which must all hold, a resource matching any
+// of the blocks is returned.
+func filterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Filters applied to the resources, a resource matching any of the filter blocks is returned",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name_prefix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Prefix of the resource names",
+				},
+				"labels": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: "Labels the resources must carry",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"match_expressions": matchExpressionsSchema(false),
+			},
+		},
+	}
+}
+
+// expandFilterQuery builds the query of a plural data source from its filter blocks.
+// The terms added by where are the data source's own filters, which apply to every block.
+func expandFilterQuery(d *schema.ResourceData, where func(g *tanzuclient.QueryGroup)) (*tanzuclient.Query, error) {
+	query := tanzuclient.NewQuery()
+
+	filters := d.Get("filter").([]interface{})
+	if len(filters) == 0 {
+		where(query.Group())
+		return query, nil
+	}
+
+	for _, f := range filters {
+		g := query.Group()
+		where(g)
+
+		if f == nil {
+			continue
+		}
+		filter := f.(map[string]interface{})
+
+		if prefix := filter["name_prefix"].(string); prefix != "" {
+			g.HasPrefix("fullName.name", prefix)
+		}
+
+		g.MatchLabels(filter["labels"].(map[string]interface{}))
+
+		for _, e := range filter["match_expressions"].([]interface{}) {
+			if e == nil {
+				continue
+			}
+			expression := e.(map[string]interface{})
+			field := tanzuclient.LabelQueryField(expression["key"].(string))
+			operator := expression["operator"].(string)
+			values := expandStringList(expression["values"].([]interface{}))
+
+			if err := validateMatchExpression(expression["key"].(string), operator, values); err != nil {
+				return nil, err
+			}
+
+			switch operator {
+			case "In":
+				g.In(field, values)
+			case "NotIn":
+				g.NotIn(field, values)
+			case "Exists":
+				g.Exists(field)
+			case "DoesNotExist":
+				g.NotExists(field)
+			}
+		}
+	}
+
+	return query, nil
+}
@@ -0,0 +1,168 @@
+package tmc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const kubernetesFieldManager = "terraform-provider-tmc"
+
+// Builds a client configuration for a Kubernetes cluster, either from the raw contents
+// of a kubeconfig or from a kubeconfig file. An empty context selects the current context.
+func newKubernetesRestConfig(kubeconfigFile string, kubeconfigRaw string, kubeContext string) (*rest.Config, error) {
+	overrides := &clientcmd.ConfigOverrides{
+		CurrentContext: kubeContext,
+	}
+
+	if kubeconfigRaw != "" {
+		config, err := clientcmd.Load([]byte(kubeconfigRaw))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse kubeconfig: %s", err)
+		}
+		return clientcmd.NewNonInteractiveClientConfig(*config, kubeContext, overrides, nil).ClientConfig()
+	}
+
+	if strings.HasPrefix(kubeconfigFile, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		kubeconfigFile = filepath.Join(home, kubeconfigFile[2:])
+	}
+
+	rules := &clientcmd.ClientConfigLoadingRules{
+		ExplicitPath: kubeconfigFile,
+	}
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+}
+
+// Applies every object of a multi-document YAML manifest to the cluster using server-side apply.
+// Objects are applied in the order they appear in, which is the order TMC generates them in.
+func applyKubernetesManifest(ctx context.Context, config *rest.Config, manifest []byte) error {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	force := true
+
+	for {
+		var object map[string]interface{}
+		if err := decoder.Decode(&object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return fmt.Errorf("unable to decode manifest: %s", err)
+		}
+		if len(object) == 0 {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{Object: object}
+		gvk := obj.GroupVersionKind()
+
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if meta.IsNoMatchError(err) {
+			// The kind may be served by a CustomResourceDefinition applied earlier in the manifest
+			mapper.Reset()
+			mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to map %s %s: %s", gvk.Kind, obj.GetName(), err)
+		}
+
+		var resourceClient dynamic.ResourceInterface
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			namespace := obj.GetNamespace()
+			if namespace == "" {
+				namespace = metav1.NamespaceDefault
+			}
+			resourceClient = dynamicClient.Resource(mapping.Resource).Namespace(namespace)
+		} else {
+			resourceClient = dynamicClient.Resource(mapping.Resource)
+		}
+
+		data, err := obj.MarshalJSON()
+		if err != nil {
+			return err
+		}
+
+		_, err = resourceClient.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+			FieldManager: kubernetesFieldManager,
+			Force:        &force,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to apply %s %s: %s", gvk.Kind, obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// Connection details of the current context of a kubeconfig
+type kubeconfigCredentials struct {
+	Host                 string
+	ClusterCACertificate string
+	Token                string
+	ClientCertificate    string
+	ClientKey            string
+}
+
+func parseKubeconfig(kubeconfigRaw string) (*kubeconfigCredentials, error) {
+	config, err := clientcmd.Load([]byte(kubeconfigRaw))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse kubeconfig: %s", err)
+	}
+
+	contextName := config.CurrentContext
+	if contextName == "" && len(config.Contexts) == 1 {
+		for name := range config.Contexts {
+			contextName = name
+		}
+	}
+
+	kubeContext, ok := config.Contexts[contextName]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig does not contain a current context")
+	}
+
+	credentials := &kubeconfigCredentials{}
+
+	if cluster, ok := config.Clusters[kubeContext.Cluster]; ok {
+		credentials.Host = cluster.Server
+		credentials.ClusterCACertificate = string(cluster.CertificateAuthorityData)
+	}
+
+	if user, ok := config.AuthInfos[kubeContext.AuthInfo]; ok {
+		credentials.Token = user.Token
+		credentials.ClientCertificate = string(user.ClientCertificateData)
+		credentials.ClientKey = string(user.ClientKeyData)
+	}
+
+	return credentials, nil
+}
@@ -0,0 +1,169 @@
+package tmc
+
+import (
+	"fmt"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Operators of the Kubernetes label selector requirements
+var labelSelectorOperators = []string{"In", "NotIn", "Exists", "DoesNotExist"}
+
+// labelSelectorSchema returns the schema of a Kubernetes label selector, matching resources
+// by their labels and by requirements on them.
+func labelSelectorSchema(description string, forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    forceNew,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"match_labels": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "Labels the resources must carry",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"match_expressions": matchExpressionsSchema(forceNew),
+			},
+		},
+	}
+}
+
+// matchExpressionsSchema returns the schema of the requirements of a label selector
+func matchExpressionsSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    forceNew,
+		Description: "Label selector requirements the resources must satisfy",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    forceNew,
+					Description: "Label key the requirement applies to",
+				},
+				"operator": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					Description:  "Relationship of the label to the values, one of In, NotIn, Exists or DoesNotExist",
+					ValidateFunc: validation.StringInSlice(labelSelectorOperators, false),
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: "Values of the label for the In and NotIn operators",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// The values of a requirement are only known once the whole configuration is read
+func validateMatchExpression(key string, operator string, values []string) error {
+	if (operator == "In" || operator == "NotIn") && len(values) == 0 {
+		return fmt.Errorf("match expression on %q with operator %s requires at least one value", key, operator)
+	}
+	if (operator == "Exists" || operator == "DoesNotExist") && len(values) > 0 {
+		return fmt.Errorf("match expression on %q with operator %s must not have values", key, operator)
+	}
+	return nil
+}
+
+func expandLabelSelector(in []interface{}) (*tanzuclient.LabelSelector, error) {
+	ls := &tanzuclient.LabelSelector{}
+
+	if len(in) < 1 || in[0] == nil {
+		return ls, nil
+	}
+	l := in[0].(map[string]interface{})
+
+	if v, ok := l["match_labels"].(map[string]interface{}); ok && len(v) > 0 {
+		ls.MatchLabels = expandStringMap(v)
+	}
+
+	if v, ok := l["match_expressions"].([]interface{}); ok && len(v) > 0 {
+		exp, err := expandMatchExpressions(v)
+		if err != nil {
+			return ls, err
+		}
+		ls.MatchExpressions = exp
+	}
+
+	return ls, nil
+}
+
+func expandStringMap(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range m {
+		result[k] = v.(string)
+	}
+	return result
+}
+
+func expandMatchExpressions(exprs []interface{}) ([]tanzuclient.MatchExpressions, error) {
+	ex := make([]tanzuclient.MatchExpressions, 0, len(exprs))
+	for _, c := range exprs {
+		if c == nil {
+			continue
+		}
+		expr := c.(map[string]interface{})
+		me := tanzuclient.MatchExpressions{
+			Key:      expr["key"].(string),
+			Operator: expr["operator"].(string),
+			Values:   expandStringList(expr["values"].([]interface{})),
+		}
+		if err := validateMatchExpression(me.Key, me.Operator, me.Values); err != nil {
+			return nil, err
+		}
+		ex = append(ex, me)
+	}
+	return ex, nil
+}
+
+// flattenLabelSelector returns no selector when it selects everything, so that an
+// omitted label_selector block does not show a difference.
+func flattenLabelSelector(labelSelector *tanzuclient.LabelSelector) []interface{} {
+	if labelSelector == nil || (len(labelSelector.MatchLabels) == 0 && len(labelSelector.MatchExpressions) == 0) {
+		return []interface{}{}
+	}
+
+	ls := make(map[string]interface{})
+
+	if labelSelector.MatchLabels != nil {
+		ls["match_labels"] = labelSelector.MatchLabels
+	}
+	ls["match_expressions"] = flattenMatchExpressions(labelSelector.MatchExpressions)
+
+	return []interface{}{ls}
+}
+
+func flattenMatchExpressions(matchExpressions []tanzuclient.MatchExpressions) []interface{} {
+	if matchExpressions != nil {
+		mes := make([]interface{}, len(matchExpressions))
+
+		for i, matchExpression := range matchExpressions {
+			me := make(map[string]interface{})
+
+			me["key"] = matchExpression.Key
+			me["operator"] = matchExpression.Operator
+			me["values"] = matchExpression.Values
+
+			mes[i] = me
+		}
+
+		return mes
+	}
+
+	return make([]interface{}, 0)
+}
@@ -0,0 +1,225 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The policy resources share their name, scope and namespace selector handling,
+// only the recipes and their inputs differ between the policy types.
+
+func policyNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Name of the Policy",
+		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+			v := val.(string)
+			if !IsValidTanzuName(v) {
+				errs = append(errs, fmt.Errorf("name should contain only lowercase letters, numbers or hyphens and should begin with either an alphabet or number"))
+			}
+			return
+		},
+	}
+}
+
+// namespaceSelectorSchema returns the schema of the selector restricting a policy to some
+// of the namespaces of its scope. TMC policies only select namespaces by expressions.
+func namespaceSelectorSchema() *schema.Schema {
+	matchExpressions := matchExpressionsSchema(false)
+	matchExpressions.Optional = false
+	matchExpressions.Required = true
+	matchExpressions.MinItems = 1
+	matchExpressions.Description = "Label selector requirements the namespaces must satisfy"
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Selects the namespaces the Policy applies to, all namespaces when omitted",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"match_expressions": matchExpressions,
+			},
+		},
+	}
+}
+
+func expandNamespaceSelector(in []interface{}) (*tanzuclient.LabelSelector, error) {
+	if len(in) == 0 || in[0] == nil {
+		return nil, nil
+	}
+
+	return expandLabelSelector(in)
+}
+
+func flattenNamespaceSelector(selector *tanzuclient.LabelSelector) []interface{} {
+	if selector == nil || len(selector.MatchExpressions) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"match_expressions": flattenMatchExpressions(selector.MatchExpressions),
+		},
+	}
+}
+
+// policyID returns the import ID of a policy, its scope followed by its name
+func policyID(scope *tanzuclient.Scope, name string) string {
+	return scopeID(scope) + "/" + name
+}
+
+// policyImporter returns the importer of a policy resource accepting the given scope types
+func policyImporter(scopeTypes ...string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		scope, rest, err := parseScopeID(d.Id(), scopeTypes...)
+		if err != nil {
+			return nil, err
+		}
+		if len(rest) != 1 || rest[0] == "" {
+			return nil, fmt.Errorf("invalid import ID %q, expected <scope>/<name> with the scope one of %s", d.Id(), strings.Join(scopeTypes, ", "))
+		}
+
+		if err := d.Set("scope", flattenScope(scope)); err != nil {
+			return nil, err
+		}
+		d.Set("name", rest[0])
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func createPolicy(d *schema.ResourceData, meta interface{}, spec *tanzuclient.PolicySpec) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	if _, err := client.CreatePolicy(scope, name, d.Get("description").(string), spec); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Failed to create %s", spec.Type),
+			Detail:   fmt.Sprintf("Error creating resource %s: %s", policyID(scope, name), err),
+		})
+		return diags
+	}
+
+	d.SetId(policyID(scope, name))
+
+	return diags
+}
+
+func updatePolicy(d *schema.ResourceData, meta interface{}, spec *tanzuclient.PolicySpec) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	if _, err := client.UpdatePolicy(scope, name, d.Get("description").(string), d.Get("resource_version").(string), spec); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Failed to update %s", spec.Type),
+			Detail:   fmt.Sprintf("Error updating resource %s: %s", policyID(scope, name), err),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+// readPolicy reads the policy and sets the attributes common to every policy type.
+// The policy is returned for the caller to set the attributes of its recipe.
+func readPolicy(d *schema.ResourceData, meta interface{}, policyType string) (*tanzuclient.Policy, diag.Diagnostics) {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	policy, err := client.GetPolicy(scope, name)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Failed to read %s", policyType),
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", policyID(scope, name), err),
+		})
+		return nil, diags
+	}
+
+	if policy.Spec == nil || policy.Spec.Type != policyType {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Failed to read %s", policyType),
+			Detail:   fmt.Sprintf("Resource %s is not a %s", policyID(scope, name), policyType),
+		})
+		return nil, diags
+	}
+
+	if policy.Meta != nil {
+		d.Set("description", policy.Meta.Description)
+		d.Set("resource_version", policy.Meta.ResourceVersion)
+	}
+
+	if err := d.Set("namespace_selector", flattenNamespaceSelector(policy.Spec.NamespaceSelector)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Failed to read %s", policyType),
+			Detail:   fmt.Sprintf("Error setting namespace selector for resource %s: %s", policyID(scope, name), err),
+		})
+		return nil, diags
+	}
+
+	d.SetId(policyID(scope, name))
+
+	return policy, diags
+}
+
+func resourceTmcPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	if err := client.DeletePolicy(scope, name); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete policy",
+			Detail:   fmt.Sprintf("Error deleting resource %s: %s", policyID(scope, name), err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -33,6 +33,7 @@ func Provider() *schema.Provider {
 		DataSourcesMap: map[string]*schema.Resource{
 			"tmc_aws_cluster":                    dataSourceAwsCluster(),
 			"tmc_aws_nodepool":                   dataSourceAwsNodePool(),
+			"tmc_aws_nodepools":                  dataSourceAwsNodePools(),
 			"tmc_workspace":                      dataSourceTmcWorkspace(),
 			"tmc_workspaces":                     dataSourceTmcWorkspaces(),
 			"tmc_cluster_group":                  dataSourceClusterGroup(),
@@ -43,8 +44,20 @@ func Provider() *schema.Provider {
 			"tmc_aws_storage_credential":         dataSourceTmcAwsStorageCredential(),
 			"tmc_observability_credential":       dataSourceTmcObservabilityCredential(),
 			"tmc_cluster_backup":                 dataSourceTmcClusterBackup(),
+			"tmc_cluster_backups":                dataSourceTmcClusterBackups(),
 			"tmc_namespace":                      dataSourceTmcNamespace(),
+			"tmc_namespaces":                     dataSourceTmcNamespaces(),
 			"tmc_management_cluster":             dataSourceTmcManagementCluster(),
+			"tmc_cluster_kubeconfig":             dataSourceTmcClusterKubeconfig(),
+			"tmc_cluster_status":                 dataSourceTmcClusterStatus(),
+			"tmc_clusters":                       dataSourceTmcClusters(),
+			"tmc_role":                           dataSourceTmcRole(),
+			"tmc_roles":                          dataSourceTmcRoles(),
+			"tmc_effective_permissions":          dataSourceTmcEffectivePermissions(),
+			"tmc_policy_insights":                dataSourceTmcPolicyInsights(),
+			"tmc_policy_dry_run":                 dataSourceTmcPolicyDryRun(),
+			"tmc_backup_location":                dataSourceTmcBackupLocation(),
+			"tmc_backup_locations":               dataSourceTmcBackupLocations(),
 		},
 
 		// List of Resources supported by the provider
@@ -61,6 +74,24 @@ func Provider() *schema.Provider {
 			"tmc_vsphere_cluster":                resourceVsphereCluster(),
 			"tmc_namespace":                      resourceTmcNamespace(),
 			"tmc_management_cluster":             resourceTmcManagementCluster(),
+			"tmc_ekscluster":                     resourceTmcEksCluster(),
+			"tmc_eks_nodegroup":                  resourceTmcEksNodegroup(),
+			"tmc_azure_credential":               resourceTmcAzureCredential(),
+			"tmc_akscluster":                     resourceTmcAksCluster(),
+			"tmc_aks_nodepool":                   resourceTmcAksNodepool(),
+			"tmc_attached_cluster":               resourceTmcAttachedCluster(),
+			"tmc_iam_policy":                     resourceTmcIamPolicy(),
+			"tmc_iam_policy_member":              resourceTmcIamPolicyMember(),
+			"tmc_security_policy":                resourceTmcSecurityPolicy(),
+			"tmc_image_policy":                   resourceTmcImagePolicy(),
+			"tmc_network_policy":                 resourceTmcNetworkPolicy(),
+			"tmc_quota_policy":                   resourceTmcQuotaPolicy(),
+			"tmc_custom_policy_template":         resourceTmcCustomPolicyTemplate(),
+			"tmc_custom_policy":                  resourceTmcCustomPolicy(),
+			"tmc_cluster_backup_schedule":        resourceTmcClusterBackupSchedule(),
+			"tmc_cluster_restore":                resourceTmcClusterRestore(),
+			"tmc_cluster_data_protection":        resourceTmcClusterDataProtection(),
+			"tmc_backup_location":                resourceTmcBackupLocation(),
 		},
 	}
 
@@ -0,0 +1,556 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTmcAksNodepool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTmcAksNodepoolCreate,
+		ReadContext:   resourceTmcAksNodepoolRead,
+		UpdateContext: resourceTmcAksNodepoolUpdate,
+		DeleteContext: resourceTmcAksNodepoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcAksNodepoolImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the AKS Nodepool",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the AKS Nodepool",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the AKS Nodepool",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !IsValidTanzuName(v) {
+						errs = append(errs, fmt.Errorf("name should contain only lowercase letters, numbers or hyphens and should begin with either an alphabet or number"))
+					}
+					return
+				},
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the AKS Cluster in which the nodepool is present",
+			},
+			"credential_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Azure credential used to provision the AKS Cluster",
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Azure subscription of the AKS Cluster",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Azure resource group of the AKS Cluster",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the AKS Nodepool",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "USER",
+				Description:  "Mode of the nodepool, either SYSTEM or USER",
+				ValidateFunc: validation.StringInSlice([]string{"SYSTEM", "USER"}, false),
+			},
+			"node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Number of nodes in the nodepool, only set on creation while auto scaling is enabled",
+				ValidateFunc: validation.IntAtLeast(0),
+				// The cluster autoscaler owns the node count of an existing nodepool while it is enabled
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != "" && len(d.Get("auto_scaling").([]interface{})) > 0
+				},
+			},
+			"vm_size": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Azure virtual machine size of the nodes",
+			},
+			"availability_zones": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Availability zones in which the nodes are placed",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"os_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "LINUX",
+				Description:  "Operating system of the nodes, either LINUX or WINDOWS",
+				ValidateFunc: validation.StringInSlice([]string{"LINUX", "WINDOWS"}, false),
+			},
+			"os_disk_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Type of the OS disk of the nodes, either MANAGED or EPHEMERAL",
+				ValidateFunc: validation.StringInSlice([]string{"MANAGED", "EPHEMERAL"}, false),
+			},
+			"os_disk_size_gb": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Size of the OS disk of the nodes in GB",
+			},
+			"max_pods": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Maximum number of Pods that can run on a node",
+			},
+			"enable_node_public_ip": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether each node is allocated its own public IP",
+			},
+			"vnet_subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the subnet the nodes join",
+			},
+			"node_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Kubernetes labels applied to the nodes",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Azure tags to be applied to the nodepool",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"auto_scaling": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Auto scaling configuration of the nodepool, the cluster autoscaler is enabled when it is set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Minimum number of nodes in the nodepool",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"max_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Maximum number of nodes in the nodepool",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"upgrade_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Upgrade configuration of the nodepool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_surge": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Maximum number or percentage of nodes that are surged during an upgrade",
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the AKS Nodepool",
+			},
+		},
+	}
+}
+
+func resourceTmcAksNodepoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	nodepool := buildAksNodepool(d)
+
+	if _, ok := d.GetOkExists("node_count"); !ok {
+		if !nodepool.Spec.AutoScaling.Enabled {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to create AKS nodepool",
+				Detail:   "node_count is required when auto_scaling is not set",
+			})
+			return diags
+		}
+		nodepool.Spec.Count = nodepool.Spec.AutoScaling.MinCount
+	}
+
+	if err := validateAksNodepool(nodepool.Spec); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create AKS nodepool",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	res, err := client.CreateAksNodepool(nodepool)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create AKS nodepool",
+			Detail:   fmt.Sprintf("Error creating resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(res.Meta.UID)
+
+	if err := waitForAksNodepoolReady(ctx, client, nodepool.FullName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create AKS nodepool",
+			Detail:   fmt.Sprintf("Error waiting for resource %s to be ready: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	return resourceTmcAksNodepoolRead(ctx, d, m)
+}
+
+func resourceTmcAksNodepoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	nodepool, err := client.GetAksNodepool(d.Get("name").(string), d.Get("cluster_name").(string), d.Get("credential_name").(string), d.Get("subscription_id").(string), d.Get("resource_group").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read AKS nodepool",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(nodepool.Meta.UID)
+	d.Set("resource_version", nodepool.Meta.ResourceVersion)
+	d.Set("description", nodepool.Meta.Description)
+
+	spec := nodepool.Spec
+	d.Set("mode", spec.Mode)
+	d.Set("node_count", spec.Count)
+	d.Set("vm_size", spec.VmSize)
+	d.Set("availability_zones", spec.AvailabilityZones)
+	d.Set("os_disk_type", spec.OsDiskType)
+	d.Set("os_disk_size_gb", spec.OsDiskSizeGb)
+	d.Set("max_pods", spec.MaxPods)
+	d.Set("enable_node_public_ip", spec.EnableNodePublicIp)
+	d.Set("vnet_subnet_id", spec.VnetSubnetID)
+	if spec.OsType != "" {
+		d.Set("os_type", spec.OsType)
+	}
+
+	if err := d.Set("node_labels", spec.NodeLabels); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read AKS nodepool",
+			Detail:   fmt.Sprintf("Error getting node labels for resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+	if err := d.Set("tags", spec.Tags); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read AKS nodepool",
+			Detail:   fmt.Sprintf("Error getting tags for resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	if spec.AutoScaling != nil && spec.AutoScaling.Enabled {
+		d.Set("auto_scaling", []interface{}{
+			map[string]interface{}{
+				"min_count": spec.AutoScaling.MinCount,
+				"max_count": spec.AutoScaling.MaxCount,
+			},
+		})
+	} else {
+		d.Set("auto_scaling", []interface{}{})
+	}
+
+	if spec.UpgradeConfig != nil && spec.UpgradeConfig.MaxSurge != "" {
+		d.Set("upgrade_config", []interface{}{
+			map[string]interface{}{
+				"max_surge": spec.UpgradeConfig.MaxSurge,
+			},
+		})
+	}
+
+	if nodepool.Status != nil {
+		d.Set("status", nodepool.Status.Phase)
+	}
+
+	return diags
+}
+
+func resourceTmcAksNodepoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	if d.HasChanges("description", "mode", "node_count", "node_labels", "tags", "auto_scaling", "upgrade_config") {
+		nodepool := buildAksNodepool(d)
+		nodepool.Meta.ResourceVersion = d.Get("resource_version").(string)
+
+		// The node count is the one last read while the autoscaler is enabled, it only has
+		// to be within the bounds of the autoscaler
+		if as := nodepool.Spec.AutoScaling; as.Enabled {
+			if nodepool.Spec.Count < as.MinCount {
+				nodepool.Spec.Count = as.MinCount
+			}
+			if nodepool.Spec.Count > as.MaxCount {
+				nodepool.Spec.Count = as.MaxCount
+			}
+		}
+
+		if err := validateAksNodepool(nodepool.Spec); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update AKS nodepool",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
+		if _, err := client.UpdateAksNodepool(nodepool); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update AKS nodepool",
+				Detail:   fmt.Sprintf("Error updating resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+
+		if err := waitForAksNodepoolReady(ctx, client, nodepool.FullName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update AKS nodepool",
+				Detail:   fmt.Sprintf("Error waiting for resource %s to be ready: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+	}
+
+	return resourceTmcAksNodepoolRead(ctx, d, m)
+}
+
+func resourceTmcAksNodepoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	name := d.Get("name").(string)
+	clusterName := d.Get("cluster_name").(string)
+	credentialName := d.Get("credential_name").(string)
+	subscriptionID := d.Get("subscription_id").(string)
+	resourceGroup := d.Get("resource_group").(string)
+
+	if err := client.DeleteAksNodepool(name, clusterName, credentialName, subscriptionID, resourceGroup); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete AKS nodepool",
+			Detail:   fmt.Sprintf("Error deleting resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"DELETING",
+			"READY",
+		},
+		Target: []string{
+			"DELETED",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.DescribeAksNodepool(name, clusterName, credentialName, subscriptionID, resourceGroup)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Phase, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := deleteStateConf.WaitForStateContext(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete AKS nodepool",
+			Detail:   fmt.Sprintf("Error waiting to delete resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// The import ID of an AKS nodepool is of the form <credential_name>/<subscription_id>/<resource_group>/<cluster_name>/<name>
+func resourceTmcAksNodepoolImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 5 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" || parts[4] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <credential_name>/<subscription_id>/<resource_group>/<cluster_name>/<name>", d.Id())
+	}
+
+	d.Set("credential_name", parts[0])
+	d.Set("subscription_id", parts[1])
+	d.Set("resource_group", parts[2])
+	d.Set("cluster_name", parts[3])
+	d.Set("name", parts[4])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func waitForAksNodepoolReady(ctx context.Context, client *tanzuclient.Client, fullName *tanzuclient.AksNodepoolFullName, timeout time.Duration) error {
+	createStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"PENDING",
+			"CREATING",
+			"UPDATING",
+			"UPGRADING",
+			"RESIZING",
+		},
+		Target: []string{
+			"READY",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetAksNodepool(fullName.Name, fullName.AksClusterName, fullName.CredentialName, fullName.SubscriptionID, fullName.ResourceGroupName)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Status.Phase, nil
+		},
+		Timeout:                   timeout,
+		Delay:                     30 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := createStateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func validateAksNodepool(spec *tanzuclient.AksNodepoolSpec) error {
+	if spec.AutoScaling == nil || !spec.AutoScaling.Enabled {
+		return nil
+	}
+	if spec.AutoScaling.MinCount > spec.AutoScaling.MaxCount {
+		return fmt.Errorf("min_count (%d) must not be greater than max_count (%d)", spec.AutoScaling.MinCount, spec.AutoScaling.MaxCount)
+	}
+	if spec.Count < spec.AutoScaling.MinCount || spec.Count > spec.AutoScaling.MaxCount {
+		return fmt.Errorf("node_count (%d) must be between min_count (%d) and max_count (%d)", spec.Count, spec.AutoScaling.MinCount, spec.AutoScaling.MaxCount)
+	}
+	return nil
+}
+
+func buildAksNodepool(d *schema.ResourceData) *tanzuclient.AksNodepool {
+	spec := &tanzuclient.AksNodepoolSpec{
+		Mode:               d.Get("mode").(string),
+		Type:               "VIRTUAL_MACHINE_SCALE_SETS",
+		Count:              d.Get("node_count").(int),
+		VmSize:             d.Get("vm_size").(string),
+		AvailabilityZones:  expandStringList(d.Get("availability_zones").([]interface{})),
+		OsType:             d.Get("os_type").(string),
+		OsDiskType:         d.Get("os_disk_type").(string),
+		OsDiskSizeGb:       d.Get("os_disk_size_gb").(int),
+		MaxPods:            d.Get("max_pods").(int),
+		EnableNodePublicIp: d.Get("enable_node_public_ip").(bool),
+		VnetSubnetID:       d.Get("vnet_subnet_id").(string),
+		NodeLabels:         d.Get("node_labels").(map[string]interface{}),
+		Tags:               d.Get("tags").(map[string]interface{}),
+	}
+
+	if v := d.Get("auto_scaling").([]interface{}); len(v) > 0 && v[0] != nil {
+		as := v[0].(map[string]interface{})
+		spec.AutoScaling = &tanzuclient.AksAutoScaling{
+			Enabled:  true,
+			MinCount: as["min_count"].(int),
+			MaxCount: as["max_count"].(int),
+		}
+	} else {
+		spec.AutoScaling = &tanzuclient.AksAutoScaling{
+			Enabled: false,
+		}
+	}
+
+	if v := d.Get("upgrade_config").([]interface{}); len(v) > 0 && v[0] != nil {
+		uc := v[0].(map[string]interface{})
+		spec.UpgradeConfig = &tanzuclient.AksUpgradeConfig{
+			MaxSurge: uc["max_surge"].(string),
+		}
+	}
+
+	return &tanzuclient.AksNodepool{
+		FullName: &tanzuclient.AksNodepoolFullName{
+			Name:              d.Get("name").(string),
+			AksClusterName:    d.Get("cluster_name").(string),
+			CredentialName:    d.Get("credential_name").(string),
+			SubscriptionID:    d.Get("subscription_id").(string),
+			ResourceGroupName: d.Get("resource_group").(string),
+		},
+		Meta: &tanzuclient.MetaData{
+			Description: d.Get("description").(string),
+		},
+		Spec: spec,
+	}
+}
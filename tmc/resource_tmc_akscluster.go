@@ -0,0 +1,563 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTmcAksCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTmcAksClusterCreate,
+		ReadContext:   resourceTmcAksClusterRead,
+		UpdateContext: resourceTmcAksClusterUpdate,
+		DeleteContext: resourceTmcAksClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcAksClusterImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the AKS Cluster",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the AKS Cluster",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the AKS Cluster",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !IsValidTanzuName(v) {
+						errs = append(errs, fmt.Errorf("name should contain only lowercase letters, numbers or hyphens and should begin with either an alphabet or number"))
+					}
+					return
+				},
+			},
+			"credential_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Azure credential used to provision the AKS Cluster",
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Azure subscription of the AKS Cluster",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Azure resource group of the AKS Cluster",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the AKS Cluster",
+			},
+			"labels": labelsSchema(),
+			"cluster_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the cluster group",
+			},
+			"proxy_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the proxy configuration to be used",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Azure location of the AKS Cluster",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Kubernetes version of the AKS Cluster",
+			},
+			"node_resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the resource group containing the agent pool nodes",
+			},
+			"sku_tier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "FREE",
+				Description:  "SKU tier of the AKS Cluster, either FREE or PAID",
+				ValidateFunc: validation.StringInSlice([]string{"FREE", "PAID"}, false),
+			},
+			"enable_rbac": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Whether Kubernetes RBAC is enabled",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Azure tags to be applied to the AKS Cluster",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"api_server_access": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Access configuration of the API server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorized_ip_ranges": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "IP ranges authorized to access the API server",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"enable_private_cluster": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+							Description: "Whether the API server is only exposed on a private endpoint",
+						},
+					},
+				},
+			},
+			"network": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Network configuration of the AKS Cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_plugin": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "kubenet",
+							Description:  "Network plugin used by the cluster, either kubenet or azure",
+							ValidateFunc: validation.StringInSlice([]string{"kubenet", "azure"}, false),
+						},
+						"network_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Description:  "Network policy used by the cluster, either calico or azure",
+							ValidateFunc: validation.StringInSlice([]string{"calico", "azure"}, false),
+						},
+						"load_balancer_sku": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "SKU of the load balancer used by the cluster",
+						},
+						"dns_prefix": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "DNS prefix of the API server",
+						},
+						"dns_service_ip": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "IP address assigned to the Kubernetes DNS service",
+						},
+						"docker_bridge_cidr": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "CIDR block assigned to the Docker bridge network",
+						},
+						"pod_cidr": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "CIDR block used by the Cluster's Pods when using kubenet",
+						},
+						"service_cidr": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "CIDR block used by the Cluster's Services",
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the AKS Cluster",
+			},
+		},
+	}
+}
+
+func resourceTmcAksClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	cluster := buildAksCluster(d)
+
+	res, err := client.CreateAksCluster(cluster)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create AKS cluster",
+			Detail:   fmt.Sprintf("Error creating resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(res.Meta.UID)
+
+	// AKS only finishes provisioning the cluster once a SYSTEM mode nodepool has been added,
+	// so the cluster is not expected to be READY here. Only wait for TMC to accept it.
+	createStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"PENDING",
+		},
+		Target: []string{
+			"CREATING",
+			"READY",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetAksCluster(cluster.FullName.Name, cluster.FullName.CredentialName, cluster.FullName.SubscriptionID, cluster.FullName.ResourceGroupName)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Status.Phase, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := createStateConf.WaitForStateContext(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create AKS cluster",
+			Detail:   fmt.Sprintf("Error waiting for resource %s to be created: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	return resourceTmcAksClusterRead(ctx, d, m)
+}
+
+func resourceTmcAksClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	cluster, err := client.GetAksCluster(d.Get("name").(string), d.Get("credential_name").(string), d.Get("subscription_id").(string), d.Get("resource_group").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read AKS cluster",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(cluster.Meta.UID)
+	d.Set("resource_version", cluster.Meta.ResourceVersion)
+	d.Set("description", cluster.Meta.Description)
+	d.Set("cluster_group", cluster.Spec.ClusterGroupName)
+	d.Set("proxy_name", cluster.Spec.ProxyName)
+
+	if err := d.Set("labels", cluster.Meta.Labels); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read AKS cluster",
+			Detail:   fmt.Sprintf("Error getting labels for resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	if config := cluster.Spec.Config; config != nil {
+		d.Set("location", config.Location)
+		d.Set("version", config.Version)
+		d.Set("node_resource_group", config.NodeResourceGroupName)
+		if config.Sku != nil && config.Sku.Tier != "" {
+			d.Set("sku_tier", config.Sku.Tier)
+		}
+		if config.AccessConfig != nil {
+			d.Set("enable_rbac", config.AccessConfig.EnableRbac)
+		}
+		if err := d.Set("tags", config.Tags); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read AKS cluster",
+				Detail:   fmt.Sprintf("Error getting tags for resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+		if err := d.Set("api_server_access", flattenAksApiServerAccessConfig(config.ApiServerAccessConfig)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read AKS cluster",
+				Detail:   fmt.Sprintf("Error getting API server access configuration for resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+		if err := d.Set("network", flattenAksNetworkConfig(config.NetworkConfig)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read AKS cluster",
+				Detail:   fmt.Sprintf("Error getting network configuration for resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+	}
+
+	if cluster.Status != nil {
+		d.Set("status", cluster.Status.Phase)
+	}
+
+	return diags
+}
+
+func resourceTmcAksClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	if d.HasChanges("description", "labels", "cluster_group", "version", "sku_tier", "tags", "api_server_access") {
+		cluster := buildAksCluster(d)
+		cluster.Meta.ResourceVersion = d.Get("resource_version").(string)
+
+		if _, err := client.UpdateAksCluster(cluster); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update AKS cluster",
+				Detail:   fmt.Sprintf("Error updating resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+
+		updateStateConf := &resource.StateChangeConf{
+			Pending: []string{
+				"UPDATING",
+				"UPGRADING",
+			},
+			Target: []string{
+				"READY",
+			},
+			Refresh: func() (interface{}, string, error) {
+				resp, err := client.GetAksCluster(cluster.FullName.Name, cluster.FullName.CredentialName, cluster.FullName.SubscriptionID, cluster.FullName.ResourceGroupName)
+				if err != nil {
+					return 0, "", err
+				}
+				return resp, resp.Status.Phase, nil
+			},
+			Timeout:                   d.Timeout(schema.TimeoutUpdate),
+			Delay:                     30 * time.Second,
+			MinTimeout:                10 * time.Second,
+			ContinuousTargetOccurence: 2,
+		}
+		if _, err := updateStateConf.WaitForStateContext(ctx); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update AKS cluster",
+				Detail:   fmt.Sprintf("Error waiting for resource %s to be ready: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+	}
+
+	return resourceTmcAksClusterRead(ctx, d, m)
+}
+
+func resourceTmcAksClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	name := d.Get("name").(string)
+	credentialName := d.Get("credential_name").(string)
+	subscriptionID := d.Get("subscription_id").(string)
+	resourceGroup := d.Get("resource_group").(string)
+
+	if err := client.DeleteAksCluster(name, credentialName, subscriptionID, resourceGroup); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete AKS cluster",
+			Detail:   fmt.Sprintf("Error deleting resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"DELETING",
+			"READY",
+			"CREATING",
+		},
+		Target: []string{
+			"DELETED",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.DescribeAksCluster(name, credentialName, subscriptionID, resourceGroup)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Phase, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := deleteStateConf.WaitForStateContext(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete AKS cluster",
+			Detail:   fmt.Sprintf("Error waiting to delete resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// The import ID of an AKS cluster is of the form <credential_name>/<subscription_id>/<resource_group>/<name>
+func resourceTmcAksClusterImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <credential_name>/<subscription_id>/<resource_group>/<name>", d.Id())
+	}
+
+	d.Set("credential_name", parts[0])
+	d.Set("subscription_id", parts[1])
+	d.Set("resource_group", parts[2])
+	d.Set("name", parts[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildAksCluster(d *schema.ResourceData) *tanzuclient.AksCluster {
+	config := &tanzuclient.AksConfig{
+		Location:              d.Get("location").(string),
+		Version:               d.Get("version").(string),
+		NodeResourceGroupName: d.Get("node_resource_group").(string),
+		Tags:                  d.Get("tags").(map[string]interface{}),
+		Sku: &tanzuclient.AksSku{
+			Tier: d.Get("sku_tier").(string),
+		},
+		AccessConfig: &tanzuclient.AksAccessConfig{
+			EnableRbac: d.Get("enable_rbac").(bool),
+		},
+		NetworkConfig: &tanzuclient.AksNetworkConfig{},
+	}
+
+	if v := d.Get("api_server_access").([]interface{}); len(v) > 0 && v[0] != nil {
+		access := v[0].(map[string]interface{})
+		config.ApiServerAccessConfig = &tanzuclient.AksApiServerAccessConfig{
+			AuthorizedIpRanges:   expandStringList(access["authorized_ip_ranges"].([]interface{})),
+			EnablePrivateCluster: access["enable_private_cluster"].(bool),
+		}
+	}
+
+	if v := d.Get("network").([]interface{}); len(v) > 0 && v[0] != nil {
+		network := v[0].(map[string]interface{})
+		config.NetworkConfig.NetworkPlugin = network["network_plugin"].(string)
+		config.NetworkConfig.NetworkPolicy = network["network_policy"].(string)
+		config.NetworkConfig.LoadBalancerSku = network["load_balancer_sku"].(string)
+		config.NetworkConfig.DnsPrefix = network["dns_prefix"].(string)
+		config.NetworkConfig.DnsServiceIp = network["dns_service_ip"].(string)
+		config.NetworkConfig.DockerBridgeCidr = network["docker_bridge_cidr"].(string)
+		if podCidr := network["pod_cidr"].(string); podCidr != "" {
+			config.NetworkConfig.PodCidrs = []string{podCidr}
+		}
+		if serviceCidr := network["service_cidr"].(string); serviceCidr != "" {
+			config.NetworkConfig.ServiceCidrs = []string{serviceCidr}
+		}
+	}
+
+	return &tanzuclient.AksCluster{
+		FullName: &tanzuclient.AksFullName{
+			Name:              d.Get("name").(string),
+			CredentialName:    d.Get("credential_name").(string),
+			SubscriptionID:    d.Get("subscription_id").(string),
+			ResourceGroupName: d.Get("resource_group").(string),
+		},
+		Meta: &tanzuclient.MetaData{
+			Description: d.Get("description").(string),
+			Labels:      d.Get("labels").(map[string]interface{}),
+		},
+		Spec: &tanzuclient.AksClusterSpec{
+			ClusterGroupName: d.Get("cluster_group").(string),
+			ProxyName:        d.Get("proxy_name").(string),
+			Config:           config,
+		},
+	}
+}
+
+func flattenAksApiServerAccessConfig(access *tanzuclient.AksApiServerAccessConfig) []interface{} {
+	if access == nil {
+		return []interface{}{}
+	}
+
+	a := make(map[string]interface{})
+
+	a["authorized_ip_ranges"] = access.AuthorizedIpRanges
+	a["enable_private_cluster"] = access.EnablePrivateCluster
+
+	return []interface{}{a}
+}
+
+func flattenAksNetworkConfig(network *tanzuclient.AksNetworkConfig) []interface{} {
+	if network == nil {
+		return []interface{}{}
+	}
+
+	n := make(map[string]interface{})
+
+	n["network_plugin"] = network.NetworkPlugin
+	n["network_policy"] = network.NetworkPolicy
+	n["load_balancer_sku"] = network.LoadBalancerSku
+	n["dns_prefix"] = network.DnsPrefix
+	n["dns_service_ip"] = network.DnsServiceIp
+	n["docker_bridge_cidr"] = network.DockerBridgeCidr
+	if len(network.PodCidrs) > 0 {
+		n["pod_cidr"] = network.PodCidrs[0]
+	}
+	if len(network.ServiceCidrs) > 0 {
+		n["service_cidr"] = network.ServiceCidrs[0]
+	}
+
+	return []interface{}{n}
+}
@@ -0,0 +1,327 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTmcAttachedCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTmcAttachedClusterCreate,
+		ReadContext:   resourceTmcAttachedClusterRead,
+		UpdateContext: resourceTmcAttachedClusterUpdate,
+		DeleteContext: resourceTmcAttachedClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcAttachedClusterImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the Attached Cluster",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the Attached Cluster",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Attached Cluster",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !IsValidTanzuName(v) {
+						errs = append(errs, fmt.Errorf("name should contain only lowercase letters, numbers or hyphens and should begin with either an alphabet or number"))
+					}
+					return
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the Attached Cluster",
+			},
+			"labels": labelsSchema(),
+			"cluster_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the cluster group",
+			},
+			"proxy_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the proxy configuration to be used by the cluster agent",
+			},
+			"kubeconfig_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path of a kubeconfig file used to apply the agent installer manifest to the cluster",
+				ConflictsWith: []string{"kubeconfig_raw"},
+			},
+			"kubeconfig_raw": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Contents of a kubeconfig used to apply the agent installer manifest to the cluster",
+				ConflictsWith: []string{"kubeconfig_file"},
+			},
+			"kube_context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Context of the kubeconfig to be used, defaults to the current context",
+			},
+			"installer_link": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Link to the agent installer manifest of the cluster",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the Attached Cluster",
+			},
+			"health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health of the Attached Cluster",
+			},
+		},
+	}
+}
+
+func resourceTmcAttachedClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	name := d.Get("name").(string)
+
+	cluster, err := client.CreateAttachedCluster(name, d.Get("cluster_group").(string), d.Get("proxy_name").(string), d.Get("description").(string), d.Get("labels").(map[string]interface{}))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to attach cluster",
+			Detail:   fmt.Sprintf("Error creating resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	d.SetId(cluster.Meta.UID)
+
+	if hasKubeconfig(d) {
+		if err := attachClusterAgent(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to attach cluster",
+				Detail:   fmt.Sprintf("Error installing the cluster agent for resource %s: %s", name, err),
+			})
+			return diags
+		}
+	}
+
+	return resourceTmcAttachedClusterRead(ctx, d, m)
+}
+
+func resourceTmcAttachedClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	cluster, err := client.GetAttachedCluster(d.Get("name").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read attached cluster",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(cluster.Meta.UID)
+	d.Set("resource_version", cluster.Meta.ResourceVersion)
+	d.Set("description", cluster.Meta.Description)
+	d.Set("cluster_group", cluster.Spec.ClusterGroupName)
+	d.Set("proxy_name", cluster.Spec.ProxyName)
+
+	if err := d.Set("labels", cluster.Meta.Labels); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read attached cluster",
+			Detail:   fmt.Sprintf("Error getting labels for resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	if cluster.Status != nil {
+		d.Set("status", cluster.Status.Phase)
+		d.Set("health", cluster.Status.Health)
+		d.Set("installer_link", cluster.Status.InstallerLink)
+	}
+
+	return diags
+}
+
+func resourceTmcAttachedClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	name := d.Get("name").(string)
+
+	if d.HasChanges("description", "labels", "cluster_group") {
+		_, err := client.UpdateAttachedCluster(name, d.Get("cluster_group").(string), d.Get("proxy_name").(string), d.Get("description").(string), d.Get("resource_version").(string), d.Get("labels").(map[string]interface{}))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update attached cluster",
+				Detail:   fmt.Sprintf("Error updating resource %s: %s", name, err),
+			})
+			return diags
+		}
+	}
+
+	// A kubeconfig supplied after the cluster was registered is used to install
+	// the agent if the cluster has not become healthy in the meantime.
+	if d.HasChanges("kubeconfig_file", "kubeconfig_raw", "kube_context") && hasKubeconfig(d) && d.Get("health").(string) != "HEALTHY" {
+		if err := attachClusterAgent(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update attached cluster",
+				Detail:   fmt.Sprintf("Error installing the cluster agent for resource %s: %s", name, err),
+			})
+			return diags
+		}
+	}
+
+	return resourceTmcAttachedClusterRead(ctx, d, m)
+}
+
+func resourceTmcAttachedClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	name := d.Get("name").(string)
+
+	if err := client.DeleteCluster(name, tanzuclient.AttachedClusterManagementClusterName, tanzuclient.AttachedClusterManagementClusterName); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to detach cluster",
+			Detail:   fmt.Sprintf("Error deleting resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"DELETING",
+		},
+		Target: []string{
+			"DELETED",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.DescribeCluster(name, tanzuclient.AttachedClusterManagementClusterName, tanzuclient.AttachedClusterManagementClusterName)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Phase, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := deleteStateConf.WaitForStateContext(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to detach cluster",
+			Detail:   fmt.Sprintf("Error waiting to delete resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// The import ID of an attached cluster is its name
+func resourceTmcAttachedClusterImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func hasKubeconfig(d *schema.ResourceData) bool {
+	return d.Get("kubeconfig_file").(string) != "" || d.Get("kubeconfig_raw").(string) != ""
+}
+
+// Applies the agent installer manifest of the cluster with the configured kubeconfig
+// and waits for the agent to report the cluster as healthy.
+func attachClusterAgent(ctx context.Context, client *tanzuclient.Client, d *schema.ResourceData, timeout time.Duration) error {
+	name := d.Get("name").(string)
+
+	cluster, err := client.GetAttachedCluster(name)
+	if err != nil {
+		return err
+	}
+	if cluster.Status == nil || cluster.Status.InstallerLink == "" {
+		return fmt.Errorf("TMC did not return an installer link for cluster %s", name)
+	}
+
+	manifest, err := client.GetAttachedClusterManifest(cluster.Status.InstallerLink)
+	if err != nil {
+		return err
+	}
+
+	config, err := newKubernetesRestConfig(d.Get("kubeconfig_file").(string), d.Get("kubeconfig_raw").(string), d.Get("kube_context").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := applyKubernetesManifest(ctx, config, manifest); err != nil {
+		return err
+	}
+
+	healthStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"PENDING",
+		},
+		Target: []string{
+			"HEALTHY",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetAttachedCluster(name)
+			if err != nil {
+				return 0, "", err
+			}
+			if resp.Status != nil && resp.Status.Health == "HEALTHY" {
+				return resp, "HEALTHY", nil
+			}
+			// Until the agent has connected TMC reports the health as UNKNOWN or DISCONNECTED
+			return resp, "PENDING", nil
+		},
+		Timeout:                   timeout,
+		Delay:                     30 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err = healthStateConf.WaitForStateContext(ctx)
+
+	return err
+}
@@ -12,6 +12,7 @@ func resourceTmcAwsDataProtectionCredential() *schema.Resource {
 	return &schema.Resource{
 		ReadContext:   resourceTmcAwsDataProtectionCredentialRead,
 		CreateContext: resourceTmcAwsDataProtectionCredentialCreate,
+		UpdateContext: resourceTmcAwsDataProtectionCredentialUpdate,
 		DeleteContext: resourceTmcAwsDataProtectionCredentialDelete,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -33,7 +34,6 @@ func resourceTmcAwsDataProtectionCredential() *schema.Resource {
 			"iam_role_arn": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "IAM Role Arn of the Tanzu Aws Data Protection Credential",
 			},
 			"credential_provider": {
@@ -85,19 +85,7 @@ func resourceTmcAwsDataProtectionCredentialCreate(ctx context.Context, d *schema
 		FullName: &tanzuclient.FullName{
 			Name: d.Get("name").(string),
 		},
-		Spec: &tanzuclient.CredentialSpec{
-			MetaData: &tanzuclient.CredentialMetaData{
-				Provider: "AWS_EC2", // Always set to this value for AWS Credentials
-			},
-			Capability: "DATA_PROTECTION", // Always set to this value for AWS Credentials
-			Data: &tanzuclient.CredentialData{
-				AwsCredential: &tanzuclient.AwsCredential{
-					IamRole: &tanzuclient.IamRole{
-						Arn: d.Get("iam_role_arn").(string),
-					},
-				},
-			},
-		},
+		Spec: buildAwsDataProtectionCredentialSpec(d),
 	}
 
 	res, err := client.CreateAwsCredential(&cred)
@@ -120,6 +108,46 @@ func resourceTmcAwsDataProtectionCredentialCreate(ctx context.Context, d *schema
 	return nil
 }
 
+func resourceTmcAwsDataProtectionCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	credName := d.Get("name").(string)
+
+	if !d.HasChange("iam_role_arn") {
+		return resourceTmcAwsDataProtectionCredentialRead(ctx, d, m)
+	}
+
+	credential, err := client.GetAwsCredential(credName)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Update AWS Data Protection Credential Failed",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	cred := tanzuclient.TmcAwsCredential{
+		FullName: credential.FullName,
+		Meta:     credential.Meta,
+		Spec:     buildAwsDataProtectionCredentialSpec(d),
+	}
+
+	if _, err := client.UpdateAwsCredential(&cred); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Update AWS Data Protection Credential Failed",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceTmcAwsDataProtectionCredentialRead(ctx, d, m)
+}
+
 func resourceTmcAwsDataProtectionCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	var diags diag.Diagnostics
@@ -142,3 +170,19 @@ func resourceTmcAwsDataProtectionCredentialDelete(ctx context.Context, d *schema
 
 	return nil
 }
+
+func buildAwsDataProtectionCredentialSpec(d *schema.ResourceData) *tanzuclient.CredentialSpec {
+	return &tanzuclient.CredentialSpec{
+		MetaData: &tanzuclient.CredentialMetaData{
+			Provider: "AWS_EC2", // Always set to this value for AWS Credentials
+		},
+		Capability: "DATA_PROTECTION", // Always set to this value for AWS Credentials
+		Data: &tanzuclient.CredentialData{
+			AwsCredential: &tanzuclient.AwsCredential{
+				IamRole: &tanzuclient.IamRole{
+					Arn: d.Get("iam_role_arn").(string),
+				},
+			},
+		},
+	}
+}
@@ -13,6 +13,7 @@ func resourceTmcAwsStorageCredential() *schema.Resource {
 	return &schema.Resource{
 		ReadContext:   resourceTmcAwsStorageCredentialRead,
 		CreateContext: resourceTmcAwsStorageCredentialCreate,
+		UpdateContext: resourceTmcAwsStorageCredentialUpdate,
 		DeleteContext: resourceTmcAwsStorageCredentialDelete,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -32,18 +33,23 @@ func resourceTmcAwsStorageCredential() *schema.Resource {
 				Description: "Capability of the Tanzu Aws Storage Credential",
 			},
 			"access_key_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				ForceNew:    true,
-				Description: "Access Key ID of the Tanzu Aws Storage Credential",
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressAccessKeyDiff,
+				Description:      "Access Key ID of the Tanzu Aws Storage Credential, only sent to TMC on creation and when key_version changes",
 			},
 			"secret_access_key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				ForceNew:    true,
-				Description: "Secret Access Key of the Tanzu Aws Storage Credential",
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressAccessKeyDiff,
+				Description:      "Secret Access Key of the Tanzu Aws Storage Credential, only sent to TMC on creation and when key_version changes",
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of the access keys, changing it sends the access keys to TMC again to rotate them in place",
 			},
 			"credential_provider": {
 				Type:        schema.TypeString,
@@ -74,6 +80,10 @@ func resourceTmcAwsStorageCredentialRead(ctx context.Context, d *schema.Resource
 	d.Set("credential_provider", credential.Spec.MetaData.Provider)
 	d.Set("status", credential.Status.Phase)
 
+	// The access keys are write-only, TMC does not return them and they are not kept in the state
+	d.Set("access_key_id", "")
+	d.Set("secret_access_key", "")
+
 	return diags
 }
 
@@ -93,21 +103,7 @@ func resourceTmcAwsStorageCredentialCreate(ctx context.Context, d *schema.Resour
 		FullName: &tanzuclient.FullName{
 			Name: d.Get("name").(string),
 		},
-		Spec: &tanzuclient.CredentialSpec{
-			MetaData: &tanzuclient.CredentialMetaData{
-				Provider: "GENERIC_S3", // Always set to this value for AWS Credentials
-			},
-			Capability: "DATA_PROTECTION", // Always set to this value for AWS Credentials
-			Data: &tanzuclient.CredentialData{
-				KeyValue: &tanzuclient.AwsCredentialKey{
-					Type: "OPAQUE_SECRET_TYPE", // Always set to this value for AWS Access Keys
-					Data: &tanzuclient.AwsAccessKey{
-						AccessKeyId:     base64.StdEncoding.EncodeToString([]byte(d.Get("access_key_id").(string))),
-						SecretAccessKey: base64.StdEncoding.EncodeToString([]byte(d.Get("secret_access_key").(string))),
-					},
-				},
-			},
-		},
+		Spec: buildAwsStorageCredentialSpec(d),
 	}
 
 	res, err := client.CreateAwsCredential(&cred)
@@ -130,6 +126,47 @@ func resourceTmcAwsStorageCredentialCreate(ctx context.Context, d *schema.Resour
 	return nil
 }
 
+func resourceTmcAwsStorageCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	credName := d.Get("name").(string)
+
+	// The access keys are only sent to TMC again when they are rotated
+	if !d.HasChange("key_version") {
+		return resourceTmcAwsStorageCredentialRead(ctx, d, m)
+	}
+
+	credential, err := client.GetAwsCredential(credName)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Update AWS Storage Credential Failed",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	cred := tanzuclient.TmcAwsCredential{
+		FullName: credential.FullName,
+		Meta:     credential.Meta,
+		Spec:     buildAwsStorageCredentialSpec(d),
+	}
+
+	if _, err := client.UpdateAwsCredential(&cred); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Update AWS Storage Credential Failed",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	return resourceTmcAwsStorageCredentialRead(ctx, d, m)
+}
+
 func resourceTmcAwsStorageCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	var diags diag.Diagnostics
@@ -152,3 +189,27 @@ func resourceTmcAwsStorageCredentialDelete(ctx context.Context, d *schema.Resour
 
 	return nil
 }
+
+func buildAwsStorageCredentialSpec(d *schema.ResourceData) *tanzuclient.CredentialSpec {
+	return &tanzuclient.CredentialSpec{
+		MetaData: &tanzuclient.CredentialMetaData{
+			Provider: "GENERIC_S3", // Always set to this value for AWS Credentials
+		},
+		Capability: "DATA_PROTECTION", // Always set to this value for AWS Credentials
+		Data: &tanzuclient.CredentialData{
+			KeyValue: &tanzuclient.AwsCredentialKey{
+				Type: "OPAQUE_SECRET_TYPE", // Always set to this value for AWS Access Keys
+				Data: &tanzuclient.AwsAccessKey{
+					AccessKeyId:     base64.StdEncoding.EncodeToString([]byte(d.Get("access_key_id").(string))),
+					SecretAccessKey: base64.StdEncoding.EncodeToString([]byte(d.Get("secret_access_key").(string))),
+				},
+			},
+		},
+	}
+}
+
+// The access keys are not kept in the state, so their differences are ignored once the
+// credential exists unless key_version changes to rotate them.
+func suppressAccessKeyDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && !d.HasChange("key_version")
+}
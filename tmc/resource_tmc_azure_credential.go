@@ -0,0 +1,186 @@
+package tmc
+
+import (
+	"context"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTmcAzureCredential() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcAzureCredentialRead,
+		CreateContext: resourceTmcAzureCredentialCreate,
+		DeleteContext: resourceTmcAzureCredentialDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the Tanzu Azure Credential",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Azure Credential",
+			},
+			"capability": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Capability of the Tanzu Azure Credential",
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Azure subscription",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Azure AD tenant of the service principal",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Application (client) ID of the service principal",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+				Description: "Client secret of the service principal",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Resource group the service principal is scoped to",
+			},
+			"azure_cloud_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "AzurePublicCloud",
+				Description: "Name of the Azure cloud environment",
+			},
+			"credential_provider": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Credential Provider of the Tanzu Azure Credential",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTmcAzureCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	credential, err := client.GetAzureCredential(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(credential.Meta.UID))
+	d.Set("capability", credential.Spec.Capability)
+	d.Set("credential_provider", credential.Spec.MetaData.Provider)
+	d.Set("status", credential.Status.Phase)
+
+	if credential.Spec.Data != nil && credential.Spec.Data.AzureCredential != nil && credential.Spec.Data.AzureCredential.ServicePrincipal != nil {
+		sp := credential.Spec.Data.AzureCredential.ServicePrincipal
+		d.Set("subscription_id", sp.SubscriptionID)
+		d.Set("tenant_id", sp.TenantID)
+		d.Set("client_id", sp.ClientID)
+		d.Set("resource_group", sp.ResourceGroup)
+		if sp.AzureCloudName != "" {
+			d.Set("azure_cloud_name", sp.AzureCloudName)
+		}
+	}
+
+	return diags
+}
+
+func resourceTmcAzureCredentialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	credName := d.Get("name").(string)
+
+	if !IsValidTanzuName(credName) {
+		return InvalidTanzuNameError("Azure Credential")
+	}
+
+	cred := tanzuclient.TmcAzureCredential{
+		FullName: &tanzuclient.FullName{
+			Name: credName,
+		},
+		Spec: &tanzuclient.AzureCredentialSpec{
+			MetaData: &tanzuclient.CredentialMetaData{
+				Provider: "AZURE_AKS", // Always set to this value for Azure Credentials
+			},
+			Capability: "MANAGED_K8S_PROVIDER", // Always set to this value for Azure Credentials
+			Data: &tanzuclient.AzureCredentialData{
+				AzureCredential: &tanzuclient.AzureCredential{
+					ServicePrincipal: &tanzuclient.AzureServicePrincipal{
+						SubscriptionID: d.Get("subscription_id").(string),
+						TenantID:       d.Get("tenant_id").(string),
+						ClientID:       d.Get("client_id").(string),
+						ClientSecret:   d.Get("client_secret").(string),
+						ResourceGroup:  d.Get("resource_group").(string),
+						AzureCloudName: d.Get("azure_cloud_name").(string),
+					},
+				},
+			},
+		},
+	}
+
+	res, err := client.CreateAzureCredential(&cred)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Create Azure Credential Failed",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId(res.Meta.UID)
+
+	return resourceTmcAzureCredentialRead(ctx, d, m)
+}
+
+func resourceTmcAzureCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	credName := d.Get("name").(string)
+
+	err := client.DeleteAzureCredential(credName)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Delete Azure Credential Failed",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
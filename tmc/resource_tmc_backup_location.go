@@ -0,0 +1,414 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Types of the backup locations, the S3 and S3-compatible ones share the AWS provider
+const (
+	backupLocationTmcType          = "tmc"
+	backupLocationS3Type           = "s3"
+	backupLocationS3CompatibleType = "s3_compatible"
+	backupLocationAzureBlobType    = "azure_blob"
+)
+
+func resourceTmcBackupLocation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcBackupLocationRead,
+		CreateContext: resourceTmcBackupLocationCreate,
+		UpdateContext: resourceTmcBackupLocationUpdate,
+		DeleteContext: resourceTmcBackupLocationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcBackupLocationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the Tanzu Backup Location",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Backup Location",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !IsValidTanzuName(v) {
+						errs = append(errs, fmt.Errorf("invalid resource name: name must start and end with a letter or number, and can contain only lowercase letters, numbers, and hyphens"))
+					}
+					return
+				},
+			},
+			"labels": labelsSchema(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of the target the backups are stored in, one of tmc, s3, s3_compatible or azure_blob",
+				ValidateFunc: validation.StringInSlice([]string{backupLocationTmcType, backupLocationS3Type, backupLocationS3CompatibleType, backupLocationAzureBlobType}, false),
+			},
+			"credential_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the data protection credential granting access to the target, e.g. a tmc_aws_storage_credential",
+			},
+			"bucket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the bucket, or of the container for Azure Blob targets",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Region of the bucket",
+			},
+			"s3_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "URL of the endpoint of an S3-compatible target",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"public_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Public URL of an S3-compatible target, used to download the backups",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"s3_force_path_style": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Address the bucket of an S3-compatible target by path instead of by subdomain",
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded CA certificate of an S3-compatible target",
+			},
+			"azure": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Storage account of an Azure Blob target",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_group": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Resource group of the storage account",
+						},
+						"storage_account": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Name of the storage account",
+						},
+						"subscription_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "ID of the subscription of the storage account",
+						},
+					},
+				},
+			},
+			"assigned_cluster_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Names of the cluster groups whose clusters may store their backups in the location",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTmcBackupLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	location, err := client.GetBackupLocation(name)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read backup location",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	for k, v := range flattenBackupLocation(location) {
+		if k == "id" {
+			d.SetId(v.(string))
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read backup location",
+				Detail:   fmt.Sprintf("Error setting %s for resource %s: %s", k, name, err),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceTmcBackupLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	spec, err := buildBackupLocationSpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.CreateBackupLocation(name, d.Get("labels").(map[string]interface{}), spec)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create backup location",
+			Detail:   fmt.Sprintf("Error creating resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	d.SetId(res.Meta.UID)
+
+	return resourceTmcBackupLocationRead(ctx, d, meta)
+}
+
+func resourceTmcBackupLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	spec, err := buildBackupLocationSpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.UpdateBackupLocation(name, d.Get("labels").(map[string]interface{}), d.Get("resource_version").(string), spec); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update backup location",
+			Detail:   fmt.Sprintf("Error updating resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	return resourceTmcBackupLocationRead(ctx, d, meta)
+}
+
+func resourceTmcBackupLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	if err := client.DeleteBackupLocation(name); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete backup location",
+			Detail:   fmt.Sprintf("Error deleting resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceTmcBackupLocationImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// The arguments accepted depend on the type, which is only known once the whole configuration is read
+func buildBackupLocationSpec(d *schema.ResourceData) (*tanzuclient.BackupLocationSpec, error) {
+	locationType := d.Get("type").(string)
+	credentialName := d.Get("credential_name").(string)
+	bucket := d.Get("bucket").(string)
+	s3URL := d.Get("s3_url").(string)
+	azure := d.Get("azure").([]interface{})
+
+	// Only S3-compatible targets are sent these settings
+	s3CompatibleSettings := d.Get("ca_cert").(string) != "" || d.Get("public_url").(string) != "" || d.Get("s3_force_path_style").(bool)
+
+	spec := &tanzuclient.BackupLocationSpec{
+		Bucket: bucket,
+		Region: d.Get("region").(string),
+	}
+
+	for _, g := range expandStringList(d.Get("assigned_cluster_groups").(*schema.Set).List()) {
+		spec.AssignedGroups = append(spec.AssignedGroups, tanzuclient.BackupLocationAssignedGroup{
+			ClusterGroup: &tanzuclient.BackupLocationClusterGroup{Name: g},
+		})
+	}
+
+	if locationType == backupLocationTmcType {
+		if credentialName != "" || bucket != "" || s3URL != "" || len(azure) > 0 || s3CompatibleSettings {
+			return nil, fmt.Errorf("credential_name, bucket, s3_url, public_url, s3_force_path_style, ca_cert and azure can not be set on a location of type tmc, its storage is provided by TMC")
+		}
+		spec.TargetProvider = tanzuclient.BackupLocationTmcProvider
+		return spec, nil
+	}
+
+	if credentialName == "" || bucket == "" {
+		return nil, fmt.Errorf("credential_name and bucket are required by a location of type %s", locationType)
+	}
+	spec.Credential = &tanzuclient.BackupLocationCredential{Name: credentialName}
+
+	switch locationType {
+	case backupLocationS3Type:
+		if s3URL != "" || len(azure) > 0 || s3CompatibleSettings {
+			return nil, fmt.Errorf("s3_url, public_url, s3_force_path_style, ca_cert and azure can not be set on a location of type s3, use s3_compatible for other S3 endpoints")
+		}
+		if spec.Region == "" {
+			return nil, fmt.Errorf("region is required by a location of type s3")
+		}
+		spec.TargetProvider = tanzuclient.BackupLocationAwsProvider
+
+	case backupLocationS3CompatibleType:
+		if s3URL == "" {
+			return nil, fmt.Errorf("s3_url is required by a location of type s3_compatible")
+		}
+		if len(azure) > 0 {
+			return nil, fmt.Errorf("azure can not be set on a location of type s3_compatible")
+		}
+		spec.TargetProvider = tanzuclient.BackupLocationAwsProvider
+		spec.CaCert = d.Get("ca_cert").(string)
+		spec.Config = &tanzuclient.BackupLocationConfig{
+			AwsConfig: &tanzuclient.BackupLocationAwsConfig{
+				S3Url:            s3URL,
+				PublicUrl:        d.Get("public_url").(string),
+				S3ForcePathStyle: d.Get("s3_force_path_style").(bool),
+			},
+		}
+
+	case backupLocationAzureBlobType:
+		if len(azure) == 0 || azure[0] == nil {
+			return nil, fmt.Errorf("the azure block is required by a location of type azure_blob")
+		}
+		if s3URL != "" || s3CompatibleSettings {
+			return nil, fmt.Errorf("s3_url, public_url, s3_force_path_style and ca_cert can not be set on a location of type azure_blob")
+		}
+		a := azure[0].(map[string]interface{})
+		spec.TargetProvider = tanzuclient.BackupLocationAzureProvider
+		spec.Config = &tanzuclient.BackupLocationConfig{
+			AzureConfig: &tanzuclient.BackupLocationAzureConfig{
+				ResourceGroup:  a["resource_group"].(string),
+				StorageAccount: a["storage_account"].(string),
+				SubscriptionID: a["subscription_id"].(string),
+			},
+		}
+	}
+
+	return spec, nil
+}
+
+// flattenBackupLocation returns the attributes of a backup location shared by the resource and data sources
+func flattenBackupLocation(location *tanzuclient.BackupLocation) map[string]interface{} {
+	l := map[string]interface{}{
+		"name":                    location.FullName.Name,
+		"type":                    "",
+		"credential_name":         "",
+		"bucket":                  "",
+		"region":                  "",
+		"s3_url":                  "",
+		"public_url":              "",
+		"s3_force_path_style":     false,
+		"ca_cert":                 "",
+		"azure":                   []interface{}{},
+		"assigned_cluster_groups": []string{},
+		"status":                  "",
+	}
+
+	if location.Meta != nil {
+		l["id"] = location.Meta.UID
+		l["labels"] = location.Meta.Labels
+		l["resource_version"] = location.Meta.ResourceVersion
+	}
+
+	if location.Status != nil {
+		l["status"] = location.Status.Phase
+	}
+
+	spec := location.Spec
+	if spec == nil {
+		return l
+	}
+
+	l["bucket"] = spec.Bucket
+	l["region"] = spec.Region
+	l["ca_cert"] = spec.CaCert
+	if spec.Credential != nil {
+		l["credential_name"] = spec.Credential.Name
+	}
+
+	groups := make([]string, 0, len(spec.AssignedGroups))
+	for _, g := range spec.AssignedGroups {
+		if g.ClusterGroup != nil {
+			groups = append(groups, g.ClusterGroup.Name)
+		}
+	}
+	sort.Strings(groups)
+	l["assigned_cluster_groups"] = groups
+
+	switch spec.TargetProvider {
+	case tanzuclient.BackupLocationTmcProvider:
+		l["type"] = backupLocationTmcType
+	case tanzuclient.BackupLocationAwsProvider:
+		l["type"] = backupLocationS3Type
+		if spec.Config != nil && spec.Config.AwsConfig != nil && spec.Config.AwsConfig.S3Url != "" {
+			l["type"] = backupLocationS3CompatibleType
+			l["s3_url"] = spec.Config.AwsConfig.S3Url
+			l["public_url"] = spec.Config.AwsConfig.PublicUrl
+			l["s3_force_path_style"] = spec.Config.AwsConfig.S3ForcePathStyle
+		}
+	case tanzuclient.BackupLocationAzureProvider:
+		l["type"] = backupLocationAzureBlobType
+		if spec.Config != nil && spec.Config.AzureConfig != nil {
+			l["azure"] = []interface{}{
+				map[string]interface{}{
+					"resource_group":  spec.Config.AzureConfig.ResourceGroup,
+					"storage_account": spec.Config.AzureConfig.StorageAccount,
+					"subscription_id": spec.Config.AzureConfig.SubscriptionID,
+				},
+			}
+		}
+	}
+
+	return l
+}
@@ -13,10 +13,13 @@ import (
 )
 
 func resourceTmcClusterBackup() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		ReadContext:   resourceTmcClusterBackupRead,
 		CreateContext: resourceTmcClusterBackupCreate,
 		DeleteContext: resourceTmcClusterBackupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -87,47 +90,7 @@ func resourceTmcClusterBackup() *schema.Resource {
 				Description: "The name list for the resources to be excluded in backup.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
-			"label_selector": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Label query over a set of resources to be included in backup.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"match_labels": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							ForceNew: true,
-						},
-						"match_expressions": {
-							Type:     schema.TypeList,
-							Optional: true,
-							ForceNew: true,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"key": {
-										Type:     schema.TypeString,
-										Computed: true,
-										ForceNew: true,
-									},
-									"operator": {
-										Type:     schema.TypeString,
-										Computed: true,
-										ForceNew: true,
-									},
-									"values": {
-										Type:     schema.TypeList,
-										Computed: true,
-										ForceNew: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
+			"label_selector": labelSelectorSchema("Label query over a set of resources to be included in backup.", true),
 			"retention_period": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -168,6 +131,12 @@ func resourceTmcClusterBackup() *schema.Resource {
 			},
 		},
 	}
+
+	for k, v := range clusterBackupStatusSchema() {
+		r.Schema[k] = v
+	}
+
+	return r
 }
 
 func resourceTmcClusterBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -207,7 +176,16 @@ func resourceTmcClusterBackupRead(ctx context.Context, d *schema.ResourceData, m
 		})
 		return diags
 	}
-	d.Set("status", backup.Status.Phase)
+	for k, v := range flattenClusterBackupStatus(backup.Status) {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse backup resource data",
+				Detail:   fmt.Sprintf("Error setting %s for resource %s: %s", k, d.Get("name"), err),
+			})
+			return diags
+		}
+	}
 
 	return diags
 }
@@ -248,22 +226,22 @@ func resourceTmcClusterBackupCreate(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	if v, ok := d.Get("included_namespaces").([]string); ok && len(v) > 0 {
+	if v := expandStringList(d.Get("included_namespaces").([]interface{})); len(v) > 0 {
 		backup.Spec.IncludedNamespaces = v
 	}
-	if v, ok := d.Get("excluded_namespaces").([]string); ok && len(v) > 0 {
+	if v := expandStringList(d.Get("excluded_namespaces").([]interface{})); len(v) > 0 {
 		backup.Spec.ExcludedNamespaces = v
 	}
-	if v, ok := d.Get("included_resources").([]string); ok && len(v) > 0 {
+	if v := expandStringList(d.Get("included_resources").([]interface{})); len(v) > 0 {
 		backup.Spec.IncludedResources = v
 	}
-	if v, ok := d.Get("excluded_resources").([]string); ok && len(v) > 0 {
+	if v := expandStringList(d.Get("excluded_resources").([]interface{})); len(v) > 0 {
 		backup.Spec.ExcludedResources = v
 	}
 	if v, ok := d.Get("include_cluster_scoped_resources").(bool); ok {
 		backup.Spec.IncludeClusterResources = v
 	}
-	if v, ok := d.Get("volume_snapshot_locations").([]string); ok && len(v) > 0 {
+	if v := expandStringList(d.Get("volume_snapshot_locations").([]interface{})); len(v) > 0 {
 		backup.Spec.VolumeSnapshotLocations = v
 	}
 
@@ -317,7 +295,12 @@ func resourceTmcClusterBackupDelete(ctx context.Context, d *schema.ResourceData,
 
 	client := meta.(*tanzuclient.Client)
 
-	err := client.DeleteClusterBackup(d.Get("name").(string), d.Get("management_cluster_name").(string), d.Get("cluster_name").(string), d.Get("provisioner_name").(string))
+	backupName := d.Get("name").(string)
+	mgmtClusterName := d.Get("management_cluster_name").(string)
+	clusterName := d.Get("cluster_name").(string)
+	provisionerName := d.Get("provisioner_name").(string)
+
+	err := client.DeleteClusterBackup(backupName, mgmtClusterName, clusterName, provisionerName)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -327,58 +310,43 @@ func resourceTmcClusterBackupDelete(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	d.SetId("")
-
-	return nil
-}
-
-func expandLabelSelector(in []interface{}) (*tanzuclient.LabelSelector, error) {
-	ls := &tanzuclient.LabelSelector{}
-
-	if len(in) < 1 {
-		return ls, nil
-	}
-	l := in[0].(map[string]interface{})
-
-	if v, ok := l["match_labels"].(map[string]interface{}); ok && len(v) > 0 {
-		ls.MatchLabels = expandStringMap(l["match_labels"].(map[string]interface{}))
+	// The backup is only gone once Velero has removed its contents from the storage location
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"DELETING",
+			"PENDING",
+			"INPROGRESS",
+			"COMPLETED",
+			"PARTIALLY_FAILED",
+			"FAILED",
+		},
+		Target: []string{
+			"DELETED",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.DescribeClusterBackup(backupName, mgmtClusterName, clusterName, provisionerName)
+			if err != nil {
+				return 0, "", err
+			}
+			if resp.Phase == "DELETE_FAILED" {
+				return resp, resp.Phase, fmt.Errorf("the deletion of the backup failed, it may still be present in storage location %s", d.Get("storage_location"))
+			}
+			return resp, resp.Phase, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
 	}
-
-	if v, ok := l["match_expressions"].([]interface{}); ok && len(v) > 0 {
-		exp, err := expandMatchExpressions(v)
-		if err != nil {
-			return ls, err
-		}
-		ls.MatchExpressions = exp
+	if _, err := deleteStateConf.WaitForStateContext(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Delete Backup Failed",
+			Detail:   fmt.Sprintf("Error waiting for Cluster Backup (%s) to be deleted: %s", backupName, err),
+		})
+		return diags
 	}
 
-	return ls, nil
-}
-
-func expandStringMap(m map[string]interface{}) map[string]interface{} {
-	result := make(map[string]interface{})
-	for k, v := range m {
-		result[k] = v.(string)
-	}
-	return result
-}
+	d.SetId("")
 
-func expandMatchExpressions(exprs []interface{}) ([]tanzuclient.MatchExpressions, error) {
-	if len(exprs) == 0 {
-		return []tanzuclient.MatchExpressions{}, nil
-	}
-	ex := make([]tanzuclient.MatchExpressions, len(exprs))
-	for i, c := range exprs {
-		expr := c.(map[string]interface{})
-		if key, ok := expr["key"]; ok {
-			ex[i].Key = key.(string)
-		}
-		if operator, ok := expr["operator"]; ok {
-			ex[i].Operator = operator.(string)
-		}
-		if values, ok := expr["values"]; ok {
-			ex[i].Values = values.([]string)
-		}
-	}
-	return ex, nil
+	return nil
 }
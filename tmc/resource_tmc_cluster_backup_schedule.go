@@ -0,0 +1,330 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTmcClusterBackupSchedule() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcClusterBackupScheduleRead,
+		CreateContext: resourceTmcClusterBackupScheduleCreate,
+		UpdateContext: resourceTmcClusterBackupScheduleUpdate,
+		DeleteContext: resourceTmcClusterBackupScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcClusterBackupScheduleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the Tanzu Cluster Backup Schedule",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique Name of the Tanzu Cluster Backup Schedule",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !IsValidTanzuName(v) {
+						errs = append(errs, fmt.Errorf("invalid resource name: name must start and end with a letter or number, and can contain only lowercase letters, numbers, and hyphens"))
+					}
+					return
+				},
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Cluster",
+			},
+			"management_cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Management Cluster",
+			},
+			"provisioner_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Management Cluster Provisioner",
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"schedule": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Cron expression of the times the backups are taken, e.g. 0 2 * * *",
+				ValidateFunc: validateCronSchedule,
+			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the schedule is paused, no backups are taken while it is",
+			},
+			"included_namespaces": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The namespaces to be included for backup from. If empty, all namespaces are included.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"excluded_namespaces": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The namespaces to be excluded in the backup.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"included_resources": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The name list for the resources to be included into backup. If empty, all resources are included.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"excluded_resources": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The name list for the resources to be excluded in backup.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"label_selector": labelSelectorSchema("Label query over a set of resources to be included in backup.", false),
+			"retention_period": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The retention period of the backups.",
+			},
+			"storage_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of a BackupStorageLocation where the backups should be stored.",
+			},
+			"snapshot_volumes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "A flag which specifies whether to take cloud snapshots of any PV's referenced in the set of objects included in the backups.",
+			},
+			"volume_snapshot_locations": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				RequiredWith: []string{"snapshot_volumes", "volume_snapshot_locations"},
+				Description:  "A list containing names of VolumeSnapshotLocations associated with the backups.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"include_cluster_scoped_resources": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "A flag which specifies whether cluster-scoped resources should be included for consideration in the backups",
+			},
+			"resource_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateCronSchedule accepts the five fields cron expressions and the descriptors, e.g. @daily, supported by Velero
+func validateCronSchedule(val interface{}, key string) (warns []string, errs []error) {
+	v := strings.TrimSpace(val.(string))
+	if strings.HasPrefix(v, "@") {
+		return
+	}
+	if fields := strings.Fields(v); len(fields) != 5 {
+		errs = append(errs, fmt.Errorf("%s must be a cron expression with 5 fields, e.g. \"0 2 * * *\", or a descriptor such as @daily, got %q", key, v))
+	}
+	return
+}
+
+func resourceTmcClusterBackupScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	schedule, err := client.GetBackupSchedule(d.Get("name").(string), d.Get("management_cluster_name").(string), d.Get("cluster_name").(string), d.Get("provisioner_name").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read backup schedule",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(schedule.Meta.UID)
+	d.Set("resource_version", schedule.Meta.ResourceVersion)
+	if err := d.Set("labels", schedule.Meta.Labels); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read backup schedule",
+			Detail:   fmt.Sprintf("Error setting labels for resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	if schedule.Spec != nil {
+		d.Set("paused", schedule.Spec.Paused)
+		if schedule.Spec.Schedule != nil {
+			d.Set("schedule", schedule.Spec.Schedule.Rate)
+		}
+		if spec := schedule.Spec.Template; spec != nil {
+			d.Set("included_namespaces", spec.IncludedNamespaces)
+			d.Set("excluded_namespaces", spec.ExcludedNamespaces)
+			d.Set("included_resources", spec.IncludedResources)
+			d.Set("excluded_resources", spec.ExcludedResources)
+			d.Set("retention_period", spec.TTL)
+			d.Set("storage_location", spec.StorageLocation)
+			d.Set("snapshot_volumes", spec.SnapshotVolumes)
+			d.Set("volume_snapshot_locations", spec.VolumeSnapshotLocations)
+			d.Set("include_cluster_scoped_resources", spec.IncludeClusterResources)
+
+			if err := d.Set("label_selector", flattenLabelSelector(spec.LabelSelector)); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read backup schedule",
+					Detail:   fmt.Sprintf("Error parsing label selectors for resource %s: %s", d.Get("name"), err),
+				})
+				return diags
+			}
+		}
+	}
+
+	if schedule.Status != nil {
+		d.Set("status", schedule.Status.Phase)
+	}
+
+	return diags
+}
+
+func resourceTmcClusterBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	schedule, err := buildBackupSchedule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.CreateBackupSchedule(schedule)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create backup schedule",
+			Detail:   fmt.Sprintf("Error creating resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(res.Meta.UID)
+
+	return resourceTmcClusterBackupScheduleRead(ctx, d, meta)
+}
+
+func resourceTmcClusterBackupScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	schedule, err := buildBackupSchedule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	schedule.Meta.UID = d.Id()
+	schedule.Meta.ResourceVersion = d.Get("resource_version").(string)
+
+	if _, err := client.UpdateBackupSchedule(schedule); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update backup schedule",
+			Detail:   fmt.Sprintf("Error updating resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	return resourceTmcClusterBackupScheduleRead(ctx, d, meta)
+}
+
+func resourceTmcClusterBackupScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteBackupSchedule(d.Get("name").(string), d.Get("management_cluster_name").(string), d.Get("cluster_name").(string), d.Get("provisioner_name").(string)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete backup schedule",
+			Detail:   fmt.Sprintf("Error deleting resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceTmcClusterBackupScheduleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <management_cluster_name>/<provisioner_name>/<cluster_name>/<name>", d.Id())
+	}
+
+	d.Set("management_cluster_name", parts[0])
+	d.Set("provisioner_name", parts[1])
+	d.Set("cluster_name", parts[2])
+	d.Set("name", parts[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildBackupSchedule(d *schema.ResourceData) (*tanzuclient.TmcBackupSchedule, error) {
+	labelSelector, err := expandLabelSelector(d.Get("label_selector").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tanzuclient.TmcBackupSchedule{
+		FullName: &tanzuclient.FullName{
+			Name:                  d.Get("name").(string),
+			ManagementClusterName: d.Get("management_cluster_name").(string),
+			ProvisionerName:       d.Get("provisioner_name").(string),
+			ClusterName:           d.Get("cluster_name").(string),
+		},
+		Meta: &tanzuclient.MetaData{
+			Labels: d.Get("labels").(map[string]interface{}),
+		},
+		Spec: &tanzuclient.BackupScheduleSpec{
+			Paused: d.Get("paused").(bool),
+			Schedule: &tanzuclient.BackupScheduleRate{
+				Rate: strings.TrimSpace(d.Get("schedule").(string)),
+			},
+			Template: &tanzuclient.ClusterBackupSpec{
+				IncludedNamespaces:      expandStringList(d.Get("included_namespaces").([]interface{})),
+				ExcludedNamespaces:      expandStringList(d.Get("excluded_namespaces").([]interface{})),
+				IncludedResources:       expandStringList(d.Get("included_resources").([]interface{})),
+				ExcludedResources:       expandStringList(d.Get("excluded_resources").([]interface{})),
+				LabelSelector:           labelSelector,
+				SnapshotVolumes:         d.Get("snapshot_volumes").(bool),
+				TTL:                     d.Get("retention_period").(string),
+				IncludeClusterResources: d.Get("include_cluster_scoped_resources").(bool),
+				StorageLocation:         d.Get("storage_location").(string),
+				VolumeSnapshotLocations: expandStringList(d.Get("volume_snapshot_locations").([]interface{})),
+			},
+		},
+	}, nil
+}
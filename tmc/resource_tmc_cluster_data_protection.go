@@ -0,0 +1,324 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTmcClusterDataProtection() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcClusterDataProtectionRead,
+		CreateContext: resourceTmcClusterDataProtectionCreate,
+		UpdateContext: resourceTmcClusterDataProtectionUpdate,
+		DeleteContext: resourceTmcClusterDataProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcClusterDataProtectionImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the data protection of the cluster",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Cluster",
+			},
+			"management_cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Management Cluster",
+			},
+			"provisioner_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Management Cluster Provisioner",
+			},
+			"disable_restic": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Do not deploy restic, which backs up the persistent volumes through the file system",
+			},
+			"default_volumes_to_fs_backup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Back up all the persistent volumes through the file system by default, instead of only the annotated ones",
+			},
+			"use_node_agent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Deploy the Velero node agent, which replaces restic in recent Velero versions",
+			},
+			"enable_csi_snapshots": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Snapshot the persistent volumes through the CSI snapshot API",
+			},
+			"enable_all_api_group_versions_backup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Back up all the versions of the API groups, instead of only the preferred ones",
+			},
+			"keep_backups": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Keep the backups of the cluster in their storage locations when data protection is disabled",
+			},
+			"resource_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTmcClusterDataProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	clusterName := d.Get("cluster_name").(string)
+
+	dataProtection, err := client.GetDataProtection(d.Get("management_cluster_name").(string), clusterName, d.Get("provisioner_name").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster data protection",
+			Detail:   fmt.Sprintf("Error reading the data protection of cluster %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	if dataProtection.Meta != nil {
+		d.SetId(dataProtection.Meta.UID)
+		d.Set("resource_version", dataProtection.Meta.ResourceVersion)
+	}
+
+	if spec := dataProtection.Spec; spec != nil {
+		d.Set("disable_restic", spec.DisableRestic)
+		d.Set("default_volumes_to_fs_backup", spec.DefaultVolumesToFsBackup)
+		d.Set("use_node_agent", spec.UseNodeAgent)
+		d.Set("enable_csi_snapshots", spec.EnableCsiSnapshots)
+		d.Set("enable_all_api_group_versions_backup", spec.EnableAllApiGroupVersionsBackup)
+	}
+
+	if dataProtection.Status != nil {
+		d.Set("status", dataProtection.Status.Phase)
+	}
+
+	return diags
+}
+
+func resourceTmcClusterDataProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	clusterName := d.Get("cluster_name").(string)
+
+	spec, err := buildDataProtectionSpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.EnableDataProtection(d.Get("management_cluster_name").(string), clusterName, d.Get("provisioner_name").(string), spec)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to enable cluster data protection",
+			Detail:   fmt.Sprintf("Error enabling the data protection of cluster %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	if res.Meta != nil {
+		d.SetId(res.Meta.UID)
+	}
+
+	if err := waitForDataProtectionReady(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to enable cluster data protection",
+			Detail:   fmt.Sprintf("Error waiting for the data protection of cluster %s to be ready: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	return resourceTmcClusterDataProtectionRead(ctx, d, meta)
+}
+
+func resourceTmcClusterDataProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	clusterName := d.Get("cluster_name").(string)
+
+	// keep_backups only applies when data protection is disabled
+	if !d.HasChangeExcept("keep_backups") {
+		return resourceTmcClusterDataProtectionRead(ctx, d, meta)
+	}
+
+	spec, err := buildDataProtectionSpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.UpdateDataProtection(d.Get("management_cluster_name").(string), clusterName, d.Get("provisioner_name").(string), d.Get("resource_version").(string), spec); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update cluster data protection",
+			Detail:   fmt.Sprintf("Error updating the data protection of cluster %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	if err := waitForDataProtectionReady(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update cluster data protection",
+			Detail:   fmt.Sprintf("Error waiting for the data protection of cluster %s to be ready: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	return resourceTmcClusterDataProtectionRead(ctx, d, meta)
+}
+
+func resourceTmcClusterDataProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	mgmtClusterName := d.Get("management_cluster_name").(string)
+	clusterName := d.Get("cluster_name").(string)
+	provisionerName := d.Get("provisioner_name").(string)
+
+	if err := client.DisableDataProtection(mgmtClusterName, clusterName, provisionerName, d.Get("keep_backups").(bool)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to disable cluster data protection",
+			Detail:   fmt.Sprintf("Error disabling the data protection of cluster %s: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"DELETING",
+			"READY",
+		},
+		Target: []string{
+			"DELETED",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.DescribeDataProtection(mgmtClusterName, clusterName, provisionerName)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Phase, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := deleteStateConf.WaitForStateContext(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to disable cluster data protection",
+			Detail:   fmt.Sprintf("Error waiting for the data protection of cluster %s to be disabled: %s", clusterName, err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// The import ID of the data protection of a cluster is of the form <management_cluster_name>/<provisioner_name>/<cluster_name>
+func resourceTmcClusterDataProtectionImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <management_cluster_name>/<provisioner_name>/<cluster_name>", d.Id())
+	}
+
+	d.Set("management_cluster_name", parts[0])
+	d.Set("provisioner_name", parts[1])
+	d.Set("cluster_name", parts[2])
+	d.Set("keep_backups", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildDataProtectionSpec(d *schema.ResourceData) (*tanzuclient.DataProtectionSpec, error) {
+	spec := &tanzuclient.DataProtectionSpec{
+		DisableRestic:                   d.Get("disable_restic").(bool),
+		DefaultVolumesToFsBackup:        d.Get("default_volumes_to_fs_backup").(bool),
+		UseNodeAgent:                    d.Get("use_node_agent").(bool),
+		EnableCsiSnapshots:              d.Get("enable_csi_snapshots").(bool),
+		EnableAllApiGroupVersionsBackup: d.Get("enable_all_api_group_versions_backup").(bool),
+	}
+
+	// File system backups are taken by restic or the node agent, one of them is required
+	if spec.DefaultVolumesToFsBackup && spec.DisableRestic && !spec.UseNodeAgent {
+		return nil, fmt.Errorf("default_volumes_to_fs_backup requires restic or the node agent, which are both disabled")
+	}
+
+	return spec, nil
+}
+
+func waitForDataProtectionReady(ctx context.Context, client *tanzuclient.Client, d *schema.ResourceData, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"PENDING",
+			"CREATING",
+			"UPDATING",
+		},
+		Target: []string{
+			"READY",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetDataProtection(d.Get("management_cluster_name").(string), d.Get("cluster_name").(string), d.Get("provisioner_name").(string))
+			if err != nil {
+				return 0, "", err
+			}
+			if resp.Status == nil || resp.Status.Phase == "" {
+				return resp, "PENDING", nil
+			}
+			return resp, resp.Status.Phase, nil
+		},
+		Timeout:    timeout,
+		Delay:      15 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -0,0 +1,311 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTmcClusterRestore() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcClusterRestoreRead,
+		CreateContext: resourceTmcClusterRestoreCreate,
+		DeleteContext: resourceTmcClusterRestoreDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcClusterRestoreImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the Tanzu Cluster Restore",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique Name of the Tanzu Cluster Restore",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !IsValidTanzuName(v) {
+						errs = append(errs, fmt.Errorf("invalid resource name: name must start and end with a letter or number, and can contain only lowercase letters, numbers, and hyphens"))
+					}
+					return
+				},
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Cluster the backup is restored into",
+			},
+			"management_cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Management Cluster",
+			},
+			"provisioner_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Tanzu Management Cluster Provisioner",
+			},
+			"backup_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the backup to restore",
+			},
+			"included_namespaces": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The namespaces of the backup to restore. If empty, all namespaces are restored.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"excluded_namespaces": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The namespaces of the backup not to restore.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"included_resources": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name list for the resources to restore. If empty, all resources are restored.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"excluded_resources": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name list for the resources not to restore.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"namespace_mapping": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Namespaces of the backup mapped to the namespaces they are restored into",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"label_selector": labelSelectorSchema("Label query over the resources of the backup to restore.", true),
+			"restore_pvs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "A flag which specifies whether the persistent volumes of the backup are restored from their snapshots.",
+			},
+			"include_cluster_scoped_resources": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "A flag which specifies whether the cluster-scoped resources of the backup are restored",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"warnings": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of warnings reported while restoring the backup",
+			},
+			"errors": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of errors reported while restoring the backup",
+			},
+		},
+	}
+}
+
+func resourceTmcClusterRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	restore, err := client.GetClusterRestore(d.Get("name").(string), d.Get("management_cluster_name").(string), d.Get("cluster_name").(string), d.Get("provisioner_name").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read cluster restore",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(restore.Meta.UID)
+
+	if spec := restore.Spec; spec != nil {
+		d.Set("backup_name", spec.BackupName)
+		d.Set("included_namespaces", spec.IncludedNamespaces)
+		d.Set("excluded_namespaces", spec.ExcludedNamespaces)
+		d.Set("included_resources", spec.IncludedResources)
+		d.Set("excluded_resources", spec.ExcludedResources)
+		d.Set("namespace_mapping", spec.NamespaceMapping)
+		d.Set("restore_pvs", spec.RestorePVs)
+		d.Set("include_cluster_scoped_resources", spec.IncludeClusterResources)
+
+		if err := d.Set("label_selector", flattenLabelSelector(spec.LabelSelector)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read cluster restore",
+				Detail:   fmt.Sprintf("Error parsing label selectors for resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+	}
+
+	if restore.Status != nil {
+		d.Set("status", restore.Status.Phase)
+		d.Set("warnings", restore.Status.Warnings)
+		d.Set("errors", restore.Status.Errors)
+	}
+
+	return diags
+}
+
+func resourceTmcClusterRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	restoreName := d.Get("name").(string)
+
+	labelSelector, err := expandLabelSelector(d.Get("label_selector").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespaceMapping := map[string]string{}
+	for k, v := range d.Get("namespace_mapping").(map[string]interface{}) {
+		namespaceMapping[k] = v.(string)
+	}
+
+	restore := &tanzuclient.TmcClusterRestore{
+		FullName: &tanzuclient.FullName{
+			Name:                  restoreName,
+			ManagementClusterName: d.Get("management_cluster_name").(string),
+			ProvisionerName:       d.Get("provisioner_name").(string),
+			ClusterName:           d.Get("cluster_name").(string),
+		},
+		Meta: &tanzuclient.MetaData{},
+		Spec: &tanzuclient.ClusterRestoreSpec{
+			BackupName:              d.Get("backup_name").(string),
+			IncludedNamespaces:      expandStringList(d.Get("included_namespaces").([]interface{})),
+			ExcludedNamespaces:      expandStringList(d.Get("excluded_namespaces").([]interface{})),
+			IncludedResources:       expandStringList(d.Get("included_resources").([]interface{})),
+			ExcludedResources:       expandStringList(d.Get("excluded_resources").([]interface{})),
+			NamespaceMapping:        namespaceMapping,
+			LabelSelector:           labelSelector,
+			RestorePVs:              d.Get("restore_pvs").(bool),
+			IncludeClusterResources: d.Get("include_cluster_scoped_resources").(bool),
+		},
+	}
+
+	res, err := client.CreateClusterRestore(restore)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create cluster restore",
+			Detail:   fmt.Sprintf("Error creating resource %s: %s", restoreName, err),
+		})
+		return diags
+	}
+
+	d.SetId(res.Meta.UID)
+
+	createStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"PENDING",
+			"NEW",
+			"INPROGRESS",
+		},
+		Target: []string{
+			"COMPLETED",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetClusterRestore(restoreName, d.Get("management_cluster_name").(string), d.Get("cluster_name").(string), d.Get("provisioner_name").(string))
+			if err != nil {
+				return 0, "", err
+			}
+			if resp.Status == nil {
+				return resp, "PENDING", nil
+			}
+			return resp, resp.Status.Phase, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      15 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := createStateConf.WaitForStateContext(ctx); err != nil {
+		// The restore is kept in the state for its warnings and errors to be inspected
+		diags = append(diags, resourceTmcClusterRestoreRead(ctx, d, meta)...)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create cluster restore",
+			Detail:   fmt.Sprintf("Error waiting for Cluster Restore (%s) to complete: %s", restoreName, err),
+		})
+		return diags
+	}
+
+	diags = resourceTmcClusterRestoreRead(ctx, d, meta)
+	if !diags.HasError() && (d.Get("warnings").(int) > 0 || d.Get("errors").(int) > 0) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Cluster restore completed with issues",
+			Detail:   fmt.Sprintf("Cluster Restore (%s) reported %d warnings and %d errors, see the restore logs in TMC", restoreName, d.Get("warnings"), d.Get("errors")),
+		})
+	}
+
+	return diags
+}
+
+func resourceTmcClusterRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	if err := client.DeleteClusterRestore(d.Get("name").(string), d.Get("management_cluster_name").(string), d.Get("cluster_name").(string), d.Get("provisioner_name").(string)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete cluster restore",
+			Detail:   fmt.Sprintf("Error deleting resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceTmcClusterRestoreImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <management_cluster_name>/<provisioner_name>/<cluster_name>/<name>", d.Id())
+	}
+
+	d.Set("management_cluster_name", parts[0])
+	d.Set("provisioner_name", parts[1])
+	d.Set("cluster_name", parts[2])
+	d.Set("name", parts[3])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,222 @@
+package tmc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Scopes custom policies can be attached to
+var customPolicyScopes = []string{
+	tanzuclient.ClusterGroupScope,
+	tanzuclient.WorkspaceScope,
+	tanzuclient.ClusterScope,
+}
+
+// Enforcement actions of custom policies, dryrun only reports the violations
+const (
+	customPolicyDenyAction   = "deny"
+	customPolicyDryRunAction = "dryrun"
+)
+
+func resourceTmcCustomPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcCustomPolicyRead,
+		CreateContext: resourceTmcCustomPolicyCreate,
+		UpdateContext: resourceTmcCustomPolicyUpdate,
+		DeleteContext: resourceTmcPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: policyImporter(customPolicyScopes...),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Scope and name of the Custom Policy",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the Custom Policy",
+			},
+			"name":  policyNameSchema(),
+			"scope": scopeSchema(customPolicyScopes...),
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the Custom Policy",
+			},
+			"template_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Custom Policy Template the Custom Policy is created from",
+			},
+			"enforcement_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      customPolicyDenyAction,
+				Description:  "Action taken on the violations, deny to reject the workloads or dryrun to only report them",
+				ValidateFunc: validation.StringInSlice([]string{customPolicyDenyAction, customPolicyDryRunAction}, false),
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Parameters of the Custom Policy as JSON, following the parameters schema of the template",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+			},
+			"target_resource": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Kubernetes resources the Custom Policy applies to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_groups": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "API groups of the resources, an empty string selects the core group and * all groups",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"kinds": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Kinds of the resources, e.g. Pod or Deployment",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"namespace_selector": namespaceSelectorSchema(),
+		},
+	}
+}
+
+func resourceTmcCustomPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policy, diags := readPolicy(d, meta, tanzuclient.CustomPolicyType)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := tanzuclient.CustomPolicyInput{}
+	if len(policy.Spec.Input) > 0 {
+		if err := json.Unmarshal(policy.Spec.Input, &input); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read custom policy",
+				Detail:   fmt.Sprintf("Error parsing the input of resource %s: %s", d.Id(), err),
+			})
+			return diags
+		}
+	}
+
+	d.Set("template_name", policy.Spec.Recipe)
+
+	if input.Audit {
+		d.Set("enforcement_action", customPolicyDryRunAction)
+	} else {
+		d.Set("enforcement_action", customPolicyDenyAction)
+	}
+
+	if len(input.Parameters) > 0 && string(input.Parameters) != "null" {
+		d.Set("parameters", string(input.Parameters))
+	} else {
+		d.Set("parameters", "")
+	}
+
+	targets := make([]interface{}, 0, len(input.TargetKubernetesResources))
+	for _, target := range input.TargetKubernetesResources {
+		targets = append(targets, map[string]interface{}{
+			"api_groups": target.ApiGroups,
+			"kinds":      target.Kinds,
+		})
+	}
+	if err := d.Set("target_resource", targets); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read custom policy",
+			Detail:   fmt.Sprintf("Error setting the target resources of resource %s: %s", d.Id(), err),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func resourceTmcCustomPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec, err := buildCustomPolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := createPolicy(d, meta, spec); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcCustomPolicyRead(ctx, d, meta)
+}
+
+func resourceTmcCustomPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec, err := buildCustomPolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := updatePolicy(d, meta, spec); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcCustomPolicyRead(ctx, d, meta)
+}
+
+func buildCustomPolicySpec(d *schema.ResourceData) (*tanzuclient.PolicySpec, error) {
+	input := &tanzuclient.CustomPolicyInput{
+		Audit: d.Get("enforcement_action").(string) == customPolicyDryRunAction,
+	}
+
+	if parameters := d.Get("parameters").(string); parameters != "" {
+		input.Parameters = json.RawMessage(parameters)
+	}
+
+	for _, t := range d.Get("target_resource").([]interface{}) {
+		if t == nil {
+			continue
+		}
+		target := t.(map[string]interface{})
+		// Empty strings are kept, they select the core API group
+		apiGroups := make([]string, 0)
+		for _, g := range target["api_groups"].([]interface{}) {
+			group, _ := g.(string)
+			apiGroups = append(apiGroups, group)
+		}
+		input.TargetKubernetesResources = append(input.TargetKubernetesResources, tanzuclient.TargetKubernetesResources{
+			ApiGroups: apiGroups,
+			Kinds:     expandStringList(target["kinds"].([]interface{})),
+		})
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceSelector, err := expandNamespaceSelector(d.Get("namespace_selector").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tanzuclient.PolicySpec{
+		Type:              tanzuclient.CustomPolicyType,
+		Recipe:            d.Get("template_name").(string),
+		RecipeVersion:     "v1",
+		Input:             data,
+		NamespaceSelector: namespaceSelector,
+	}, nil
+}
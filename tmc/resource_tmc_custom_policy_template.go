@@ -0,0 +1,338 @@
+package tmc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const gatekeeperAdmissionTarget = "admission.k8s.gatekeeper.sh"
+
+// Kinds of the constraints created from the templates, e.g. RequireLabels
+var kindPattern = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+type constraintTemplateTarget struct {
+	Target string `json:"target"`
+	Rego   string `json:"rego"`
+}
+
+type constraintTemplateValidation struct {
+	OpenAPIV3Schema json.RawMessage `json:"openAPIV3Schema,omitempty"`
+}
+
+// The subset of a Gatekeeper constraint template managed by the provider
+type constraintTemplate struct {
+	ApiVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec struct {
+		Crd struct {
+			Spec struct {
+				Names struct {
+					Kind string `json:"kind"`
+				} `json:"names"`
+				Validation *constraintTemplateValidation `json:"validation,omitempty"`
+			} `json:"spec"`
+		} `json:"crd"`
+		Targets []constraintTemplateTarget `json:"targets"`
+	} `json:"spec"`
+}
+
+func resourceTmcCustomPolicyTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcCustomPolicyTemplateRead,
+		CreateContext: resourceTmcCustomPolicyTemplateCreate,
+		UpdateContext: resourceTmcCustomPolicyTemplateUpdate,
+		DeleteContext: resourceTmcCustomPolicyTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			_, err := constraintKind(d.Get("name").(string), d.Get("kind").(string))
+			return err
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the Custom Policy Template",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the Custom Policy Template",
+			},
+			"name": policyNameSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the Custom Policy Template",
+			},
+			"kind": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Kind of the constraints created from the template, its lowercased form must be the name of the template, derived from the name when omitted, e.g. RequireLabels for requirelabels",
+				ValidateFunc: validation.StringMatch(kindPattern, "must be a Kubernetes kind, e.g. RequireLabels"),
+			},
+			"rego": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Rego source of the template, reporting the violations of the admitted objects",
+			},
+			"parameters_schema": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "OpenAPI v3 schema of the parameters of the policies created from the template, as JSON",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+			},
+			"data_inventory": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Kubernetes resource types the Rego of the template looks up in the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "API group of the resource type, empty for the core group",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "API version of the resource type",
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Kind of the resource type",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTmcCustomPolicyTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Id()
+
+	template, err := client.GetCustomPolicyTemplate(name)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read custom policy template",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	d.Set("name", name)
+	if template.Meta != nil {
+		d.Set("description", template.Meta.Description)
+		d.Set("resource_version", template.Meta.ResourceVersion)
+	}
+
+	if template.Spec != nil {
+		object := constraintTemplate{}
+		if err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(template.Spec.Object), 4096).Decode(&object); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read custom policy template",
+				Detail:   fmt.Sprintf("Error parsing the constraint template of resource %s: %s", name, err),
+			})
+			return diags
+		}
+
+		d.Set("kind", object.Spec.Crd.Spec.Names.Kind)
+		for _, target := range object.Spec.Targets {
+			if target.Target == gatekeeperAdmissionTarget {
+				d.Set("rego", target.Rego)
+			}
+		}
+		if v := object.Spec.Crd.Spec.Validation; v != nil && len(v.OpenAPIV3Schema) > 0 {
+			d.Set("parameters_schema", string(v.OpenAPIV3Schema))
+		} else {
+			d.Set("parameters_schema", "")
+		}
+
+		inventory := make([]interface{}, 0, len(template.Spec.DataInventory))
+		for _, i := range template.Spec.DataInventory {
+			inventory = append(inventory, map[string]interface{}{
+				"group":   i.Group,
+				"version": i.Version,
+				"kind":    i.Kind,
+			})
+		}
+		if err := d.Set("data_inventory", inventory); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read custom policy template",
+				Detail:   fmt.Sprintf("Error setting the data inventory of resource %s: %s", name, err),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceTmcCustomPolicyTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	spec, err := buildCustomPolicyTemplateSpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.CreateCustomPolicyTemplate(name, d.Get("description").(string), spec); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create custom policy template",
+			Detail:   fmt.Sprintf("Error creating resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	d.SetId(name)
+
+	return resourceTmcCustomPolicyTemplateRead(ctx, d, meta)
+}
+
+func resourceTmcCustomPolicyTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	spec, err := buildCustomPolicyTemplateSpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.UpdateCustomPolicyTemplate(name, d.Get("description").(string), d.Get("resource_version").(string), spec); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update custom policy template",
+			Detail:   fmt.Sprintf("Error updating resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	return resourceTmcCustomPolicyTemplateRead(ctx, d, meta)
+}
+
+func resourceTmcCustomPolicyTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+
+	if err := client.DeleteCustomPolicyTemplate(name); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete custom policy template",
+			Detail:   fmt.Sprintf("Error deleting resource %s: %s", name, err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func buildCustomPolicyTemplateSpec(d *schema.ResourceData) (*tanzuclient.CustomPolicyTemplateSpec, error) {
+	kind, err := constraintKind(d.Get("name").(string), d.Get("kind").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	object := constraintTemplate{
+		ApiVersion: "templates.gatekeeper.sh/v1beta1",
+		Kind:       tanzuclient.CustomPolicyTemplateObjectType,
+	}
+	object.Metadata.Name = d.Get("name").(string)
+	object.Spec.Crd.Spec.Names.Kind = kind
+	object.Spec.Targets = []constraintTemplateTarget{
+		{
+			Target: gatekeeperAdmissionTarget,
+			Rego:   d.Get("rego").(string),
+		},
+	}
+
+	if parametersSchema := d.Get("parameters_schema").(string); parametersSchema != "" {
+		object.Spec.Crd.Spec.Validation = &constraintTemplateValidation{
+			OpenAPIV3Schema: json.RawMessage(parametersSchema),
+		}
+	}
+
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build the constraint template: %s", err)
+	}
+
+	spec := &tanzuclient.CustomPolicyTemplateSpec{
+		ObjectType:   tanzuclient.CustomPolicyTemplateObjectType,
+		TemplateType: tanzuclient.CustomPolicyTemplateTemplateType,
+		Object:       string(data),
+	}
+
+	for _, i := range d.Get("data_inventory").([]interface{}) {
+		if i == nil {
+			continue
+		}
+		inventory := i.(map[string]interface{})
+		spec.DataInventory = append(spec.DataInventory, tanzuclient.DataInventory{
+			Group:   inventory["group"].(string),
+			Version: inventory["version"].(string),
+			Kind:    inventory["kind"].(string),
+		})
+	}
+
+	return spec, nil
+}
+
+// constraintKind returns the kind of the constraints of a template. Gatekeeper requires the
+// name of a constraint template to be its lowercased kind, and TMC the name of the template
+// to be the name of the constraint template, so the kind is derived from the name when it is
+// omitted, e.g. requirelabels yields Requirelabels.
+func constraintKind(name, kind string) (string, error) {
+	// The name and kind are empty while they are not known yet
+	if name == "" {
+		return kind, nil
+	}
+	if kind == "" {
+		kind = strings.ToUpper(name[:1]) + name[1:]
+		if !kindPattern.MatchString(kind) {
+			return "", fmt.Errorf("the kind of template %s cannot be derived from its name, the name must begin with a letter and contain no hyphens", name)
+		}
+		return kind, nil
+	}
+	if strings.ToLower(kind) != name {
+		return "", fmt.Errorf("the kind %s of template %s must be the name of the template once lowercased, e.g. RequireLabels for requirelabels", kind, name)
+	}
+	return kind, nil
+}
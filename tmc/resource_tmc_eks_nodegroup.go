@@ -0,0 +1,524 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTmcEksNodegroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTmcEksNodegroupCreate,
+		ReadContext:   resourceTmcEksNodegroupRead,
+		UpdateContext: resourceTmcEksNodegroupUpdate,
+		DeleteContext: resourceTmcEksNodegroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcEksNodegroupImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the EKS Nodegroup",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the EKS Nodegroup",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the EKS Nodegroup",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !IsValidTanzuName(v) {
+						errs = append(errs, fmt.Errorf("name should contain only lowercase letters, numbers or hyphens and should begin with either an alphabet or number"))
+					}
+					return
+				},
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the EKS Cluster in which the nodegroup is present",
+			},
+			"credential_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the AWS account credential used to provision the EKS Cluster",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "AWS region of the EKS Cluster",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the EKS Nodegroup",
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ARN of the IAM role assumed by the worker nodes",
+			},
+			"ami_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "AMI type of the worker nodes",
+			},
+			"capacity_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Capacity type of the worker nodes, either ON_DEMAND or SPOT",
+			},
+			"root_disk_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Root disk size of the worker nodes in GiB",
+			},
+			"instance_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Instance types used for the worker nodes",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: "IDs of the subnets in which the worker nodes are launched",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"node_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Kubernetes labels applied to the worker nodes",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "AWS tags applied to the nodegroup",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"remote_access": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Remote access configuration of the worker nodes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ssh_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "Name of the EC2 SSH Keypair",
+						},
+						"security_groups": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "IDs of the security groups allowed remote access to the worker nodes",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"scaling_config": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Scaling configuration of the nodegroup",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_size": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Desired number of worker nodes",
+						},
+						"min_size": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Minimum number of worker nodes",
+						},
+						"max_size": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Maximum number of worker nodes",
+						},
+					},
+				},
+			},
+			"update_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Number of nodes which can be unavailable during a nodegroup update",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable_nodes": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ConflictsWith: []string{"update_config.0.max_unavailable_percentage"},
+							Description:   "Maximum number of nodes unavailable at once",
+						},
+						"max_unavailable_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ConflictsWith: []string{"update_config.0.max_unavailable_nodes"},
+							Description:   "Maximum percentage of nodes unavailable at once",
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the EKS Nodegroup",
+			},
+		},
+	}
+}
+
+func resourceTmcEksNodegroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	nodegroup := buildEksNodegroup(d)
+
+	if err := validateEksScalingConfig(nodegroup.Spec.ScalingConfig); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create EKS nodegroup",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	res, err := client.CreateEksNodegroup(nodegroup)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create EKS nodegroup",
+			Detail:   fmt.Sprintf("Error creating resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(res.Meta.UID)
+
+	if err := waitForEksNodegroupReady(ctx, client, nodegroup.FullName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create EKS nodegroup",
+			Detail:   fmt.Sprintf("Error waiting for resource %s to be ready: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	return resourceTmcEksNodegroupRead(ctx, d, m)
+}
+
+func resourceTmcEksNodegroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	nodegroup, err := client.GetEksNodegroup(d.Get("name").(string), d.Get("cluster_name").(string), d.Get("credential_name").(string), d.Get("region").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read EKS nodegroup",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(nodegroup.Meta.UID)
+	d.Set("resource_version", nodegroup.Meta.ResourceVersion)
+	d.Set("description", nodegroup.Meta.Description)
+
+	spec := nodegroup.Spec
+	d.Set("role_arn", spec.RoleArn)
+	d.Set("ami_type", spec.AmiType)
+	d.Set("capacity_type", spec.CapacityType)
+	d.Set("root_disk_size", spec.RootDiskSize)
+	d.Set("instance_types", spec.InstanceTypes)
+	d.Set("subnet_ids", spec.SubnetIds)
+
+	if err := d.Set("node_labels", spec.NodeLabels); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read EKS nodegroup",
+			Detail:   fmt.Sprintf("Error getting node labels for resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+	if err := d.Set("tags", spec.Tags); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read EKS nodegroup",
+			Detail:   fmt.Sprintf("Error getting tags for resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	if spec.RemoteAccess != nil {
+		d.Set("remote_access", []interface{}{
+			map[string]interface{}{
+				"ssh_key":         spec.RemoteAccess.SshKey,
+				"security_groups": spec.RemoteAccess.SecurityGroups,
+			},
+		})
+	}
+
+	if spec.ScalingConfig != nil {
+		d.Set("scaling_config", []interface{}{
+			map[string]interface{}{
+				"desired_size": spec.ScalingConfig.DesiredSize,
+				"min_size":     spec.ScalingConfig.MinSize,
+				"max_size":     spec.ScalingConfig.MaxSize,
+			},
+		})
+	}
+
+	if spec.UpdateConfig != nil && (spec.UpdateConfig.MaxUnavailableNodes != 0 || spec.UpdateConfig.MaxUnavailablePercentage != 0) {
+		d.Set("update_config", []interface{}{
+			map[string]interface{}{
+				"max_unavailable_nodes":      spec.UpdateConfig.MaxUnavailableNodes,
+				"max_unavailable_percentage": spec.UpdateConfig.MaxUnavailablePercentage,
+			},
+		})
+	}
+
+	if nodegroup.Status != nil {
+		d.Set("status", nodegroup.Status.Phase)
+	}
+
+	return diags
+}
+
+func resourceTmcEksNodegroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	if d.HasChanges("description", "node_labels", "tags", "scaling_config", "update_config") {
+		nodegroup := buildEksNodegroup(d)
+		nodegroup.Meta.ResourceVersion = d.Get("resource_version").(string)
+
+		if err := validateEksScalingConfig(nodegroup.Spec.ScalingConfig); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update EKS nodegroup",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
+		if _, err := client.UpdateEksNodegroup(nodegroup); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update EKS nodegroup",
+				Detail:   fmt.Sprintf("Error updating resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+
+		if err := waitForEksNodegroupReady(ctx, client, nodegroup.FullName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update EKS nodegroup",
+				Detail:   fmt.Sprintf("Error waiting for resource %s to be ready: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+	}
+
+	return resourceTmcEksNodegroupRead(ctx, d, m)
+}
+
+func resourceTmcEksNodegroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	name := d.Get("name").(string)
+	clusterName := d.Get("cluster_name").(string)
+	credentialName := d.Get("credential_name").(string)
+	region := d.Get("region").(string)
+
+	if err := client.DeleteEksNodegroup(name, clusterName, credentialName, region); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete EKS nodegroup",
+			Detail:   fmt.Sprintf("Error deleting resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"DELETING",
+			"READY",
+		},
+		Target: []string{
+			"DELETED",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.DescribeEksNodegroup(name, clusterName, credentialName, region)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Phase, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := deleteStateConf.WaitForStateContext(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete EKS nodegroup",
+			Detail:   fmt.Sprintf("Error waiting to delete resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// The import ID of an EKS nodegroup is of the form <credential_name>/<region>/<cluster_name>/<name>
+func resourceTmcEksNodegroupImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <credential_name>/<region>/<cluster_name>/<name>", d.Id())
+	}
+
+	d.Set("credential_name", parts[0])
+	d.Set("region", parts[1])
+	d.Set("cluster_name", parts[2])
+	d.Set("name", parts[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func waitForEksNodegroupReady(ctx context.Context, client *tanzuclient.Client, fullName *tanzuclient.EksNodegroupFullName, timeout time.Duration) error {
+	createStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"PENDING",
+			"CREATING",
+			"UPDATING",
+			"UPGRADING",
+			"RESIZING",
+		},
+		Target: []string{
+			"READY",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetEksNodegroup(fullName.Name, fullName.EksClusterName, fullName.CredentialName, fullName.Region)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Status.Phase, nil
+		},
+		Timeout:                   timeout,
+		Delay:                     30 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := createStateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func validateEksScalingConfig(s *tanzuclient.EksScalingConfig) error {
+	if s.MinSize > s.MaxSize {
+		return fmt.Errorf("min_size (%d) must not be greater than max_size (%d)", s.MinSize, s.MaxSize)
+	}
+	if s.DesiredSize < s.MinSize || s.DesiredSize > s.MaxSize {
+		return fmt.Errorf("desired_size (%d) must be between min_size (%d) and max_size (%d)", s.DesiredSize, s.MinSize, s.MaxSize)
+	}
+	return nil
+}
+
+func buildEksNodegroup(d *schema.ResourceData) *tanzuclient.EksNodegroup {
+	spec := &tanzuclient.EksNodegroupSpec{
+		RoleArn:       d.Get("role_arn").(string),
+		AmiType:       d.Get("ami_type").(string),
+		CapacityType:  d.Get("capacity_type").(string),
+		RootDiskSize:  d.Get("root_disk_size").(int),
+		InstanceTypes: expandStringList(d.Get("instance_types").([]interface{})),
+		SubnetIds:     expandStringList(d.Get("subnet_ids").([]interface{})),
+		NodeLabels:    d.Get("node_labels").(map[string]interface{}),
+		Tags:          d.Get("tags").(map[string]interface{}),
+		ScalingConfig: &tanzuclient.EksScalingConfig{},
+	}
+
+	if v := d.Get("remote_access").([]interface{}); len(v) > 0 && v[0] != nil {
+		ra := v[0].(map[string]interface{})
+		spec.RemoteAccess = &tanzuclient.EksRemoteAccess{
+			SshKey:         ra["ssh_key"].(string),
+			SecurityGroups: expandStringList(ra["security_groups"].([]interface{})),
+		}
+	}
+
+	if v := d.Get("scaling_config").([]interface{}); len(v) > 0 && v[0] != nil {
+		sc := v[0].(map[string]interface{})
+		spec.ScalingConfig.DesiredSize = sc["desired_size"].(int)
+		spec.ScalingConfig.MinSize = sc["min_size"].(int)
+		spec.ScalingConfig.MaxSize = sc["max_size"].(int)
+	}
+
+	if v := d.Get("update_config").([]interface{}); len(v) > 0 && v[0] != nil {
+		uc := v[0].(map[string]interface{})
+		spec.UpdateConfig = &tanzuclient.EksUpdateConfig{
+			MaxUnavailableNodes:      uc["max_unavailable_nodes"].(int),
+			MaxUnavailablePercentage: uc["max_unavailable_percentage"].(int),
+		}
+	}
+
+	return &tanzuclient.EksNodegroup{
+		FullName: &tanzuclient.EksNodegroupFullName{
+			Name:           d.Get("name").(string),
+			EksClusterName: d.Get("cluster_name").(string),
+			CredentialName: d.Get("credential_name").(string),
+			Region:         d.Get("region").(string),
+		},
+		Meta: &tanzuclient.MetaData{
+			Description: d.Get("description").(string),
+		},
+		Spec: spec,
+	}
+}
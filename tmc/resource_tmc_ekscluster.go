@@ -0,0 +1,506 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTmcEksCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTmcEksClusterCreate,
+		ReadContext:   resourceTmcEksClusterRead,
+		UpdateContext: resourceTmcEksClusterUpdate,
+		DeleteContext: resourceTmcEksClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcEksClusterImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Unique ID of the EKS Cluster",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the EKS Cluster",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the EKS Cluster",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					if !IsValidTanzuName(v) {
+						errs = append(errs, fmt.Errorf("name should contain only lowercase letters, numbers or hyphens and should begin with either an alphabet or number"))
+					}
+					return
+				},
+			},
+			"credential_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the AWS account credential used to provision the EKS Cluster",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "AWS region of the EKS Cluster",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the EKS Cluster",
+			},
+			"labels": labelsSchema(),
+			"cluster_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the cluster group",
+			},
+			"proxy_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the proxy configuration to be used",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Kubernetes version of the EKS Cluster",
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ARN of the IAM role that provides permissions for the Kubernetes control plane",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "AWS tags to be applied to the EKS Cluster",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"service_cidr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "CIDR block used by the Cluster's Services",
+			},
+			"logging": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Control plane log types to be exported to CloudWatch",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_server": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"audit": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"authenticator": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"controller_manager": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"scheduler": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"vpc": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "VPC configuration of the EKS Cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_ids": {
+							Type:        schema.TypeList,
+							Required:    true,
+							ForceNew:    true,
+							MinItems:    2,
+							Description: "IDs of the subnets for the control plane network interfaces",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"security_groups": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "IDs of the security groups attached to the control plane network interfaces",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"enable_private_access": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the private API server endpoint is enabled",
+						},
+						"enable_public_access": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the public API server endpoint is enabled",
+						},
+						"public_access_cidrs": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							Description: "CIDR blocks allowed to access the public API server endpoint",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the EKS Cluster",
+			},
+		},
+	}
+}
+
+func resourceTmcEksClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	clusterName := d.Get("name").(string)
+	credentialName := d.Get("credential_name").(string)
+	region := d.Get("region").(string)
+
+	cluster := buildEksCluster(d)
+
+	res, err := client.CreateEksCluster(cluster)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create EKS cluster",
+			Detail:   fmt.Sprintf("Error creating resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(res.Meta.UID)
+
+	if err := waitForEksClusterReady(ctx, client, clusterName, credentialName, region, d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create EKS cluster",
+			Detail:   fmt.Sprintf("Error waiting for resource %s to be ready: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	return resourceTmcEksClusterRead(ctx, d, m)
+}
+
+func resourceTmcEksClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	cluster, err := client.GetEksCluster(d.Get("name").(string), d.Get("credential_name").(string), d.Get("region").(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read EKS cluster",
+			Detail:   fmt.Sprintf("Error reading resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId(cluster.Meta.UID)
+	d.Set("resource_version", cluster.Meta.ResourceVersion)
+	d.Set("description", cluster.Meta.Description)
+	d.Set("cluster_group", cluster.Spec.ClusterGroupName)
+	d.Set("proxy_name", cluster.Spec.ProxyName)
+
+	if err := d.Set("labels", cluster.Meta.Labels); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read EKS cluster",
+			Detail:   fmt.Sprintf("Error getting labels for resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	if config := cluster.Spec.Config; config != nil {
+		d.Set("version", config.Version)
+		d.Set("role_arn", config.RoleArn)
+		if err := d.Set("tags", config.Tags); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read EKS cluster",
+				Detail:   fmt.Sprintf("Error getting tags for resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+		if config.KubernetesNetworkConfig != nil {
+			d.Set("service_cidr", config.KubernetesNetworkConfig.ServiceCidr)
+		}
+		if err := d.Set("logging", flattenEksLogging(config.Logging)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read EKS cluster",
+				Detail:   fmt.Sprintf("Error getting logging configuration for resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+		if err := d.Set("vpc", flattenEksVpcConfig(config.Vpc)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read EKS cluster",
+				Detail:   fmt.Sprintf("Error getting VPC configuration for resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+	}
+
+	if cluster.Status != nil {
+		d.Set("status", cluster.Status.Phase)
+	}
+
+	return diags
+}
+
+func resourceTmcEksClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	if d.HasChanges("description", "labels", "cluster_group", "version", "tags", "logging", "vpc") {
+		cluster := buildEksCluster(d)
+		cluster.Meta.ResourceVersion = d.Get("resource_version").(string)
+
+		if _, err := client.UpdateEksCluster(cluster); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update EKS cluster",
+				Detail:   fmt.Sprintf("Error updating resource %s: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+
+		if err := waitForEksClusterReady(ctx, client, d.Get("name").(string), d.Get("credential_name").(string), d.Get("region").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update EKS cluster",
+				Detail:   fmt.Sprintf("Error waiting for resource %s to be ready: %s", d.Get("name"), err),
+			})
+			return diags
+		}
+	}
+
+	return resourceTmcEksClusterRead(ctx, d, m)
+}
+
+func resourceTmcEksClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*tanzuclient.Client)
+
+	clusterName := d.Get("name").(string)
+	credentialName := d.Get("credential_name").(string)
+	region := d.Get("region").(string)
+
+	if err := client.DeleteEksCluster(clusterName, credentialName, region); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete EKS cluster",
+			Detail:   fmt.Sprintf("Error deleting resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"DELETING",
+			"READY",
+		},
+		Target: []string{
+			"DELETED",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.DescribeEksCluster(clusterName, credentialName, region)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Phase, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := deleteStateConf.WaitForStateContext(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete EKS cluster",
+			Detail:   fmt.Sprintf("Error waiting to delete resource %s: %s", d.Get("name"), err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// The import ID of an EKS cluster is of the form <credential_name>/<region>/<name>
+func resourceTmcEksClusterImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <credential_name>/<region>/<name>", d.Id())
+	}
+
+	d.Set("credential_name", parts[0])
+	d.Set("region", parts[1])
+	d.Set("name", parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func waitForEksClusterReady(ctx context.Context, client *tanzuclient.Client, name string, credentialName string, region string, timeout time.Duration) error {
+	createStateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"PENDING",
+			"CREATING",
+			"UPDATING",
+			"UPGRADING",
+		},
+		Target: []string{
+			"READY",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetEksCluster(name, credentialName, region)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Status.Phase, nil
+		},
+		Timeout:                   timeout,
+		Delay:                     30 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := createStateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func buildEksCluster(d *schema.ResourceData) *tanzuclient.EksCluster {
+	config := &tanzuclient.EksConfig{
+		Version: d.Get("version").(string),
+		RoleArn: d.Get("role_arn").(string),
+		Tags:    d.Get("tags").(map[string]interface{}),
+		Logging: &tanzuclient.EksLogging{},
+		Vpc:     &tanzuclient.EksVpcConfig{},
+	}
+
+	if v, ok := d.GetOk("service_cidr"); ok {
+		config.KubernetesNetworkConfig = &tanzuclient.EksKubernetesNetworkConfig{
+			ServiceCidr: v.(string),
+		}
+	}
+
+	if v := d.Get("logging").([]interface{}); len(v) > 0 && v[0] != nil {
+		logging := v[0].(map[string]interface{})
+		config.Logging.ApiServer = logging["api_server"].(bool)
+		config.Logging.Audit = logging["audit"].(bool)
+		config.Logging.Authenticator = logging["authenticator"].(bool)
+		config.Logging.ControllerManager = logging["controller_manager"].(bool)
+		config.Logging.Scheduler = logging["scheduler"].(bool)
+	}
+
+	if v := d.Get("vpc").([]interface{}); len(v) > 0 && v[0] != nil {
+		vpc := v[0].(map[string]interface{})
+		config.Vpc.SubnetIds = expandStringList(vpc["subnet_ids"].([]interface{}))
+		config.Vpc.SecurityGroups = expandStringList(vpc["security_groups"].([]interface{}))
+		config.Vpc.EnablePrivateAccess = vpc["enable_private_access"].(bool)
+		config.Vpc.EnablePublicAccess = vpc["enable_public_access"].(bool)
+		config.Vpc.PublicAccessCidrs = expandStringList(vpc["public_access_cidrs"].([]interface{}))
+	}
+
+	return &tanzuclient.EksCluster{
+		FullName: &tanzuclient.EksFullName{
+			Name:           d.Get("name").(string),
+			CredentialName: d.Get("credential_name").(string),
+			Region:         d.Get("region").(string),
+		},
+		Meta: &tanzuclient.MetaData{
+			Description: d.Get("description").(string),
+			Labels:      d.Get("labels").(map[string]interface{}),
+		},
+		Spec: &tanzuclient.EksClusterSpec{
+			ClusterGroupName: d.Get("cluster_group").(string),
+			ProxyName:        d.Get("proxy_name").(string),
+			Config:           config,
+		},
+	}
+}
+
+func flattenEksLogging(logging *tanzuclient.EksLogging) []interface{} {
+	if logging == nil {
+		return []interface{}{}
+	}
+
+	l := make(map[string]interface{})
+
+	l["api_server"] = logging.ApiServer
+	l["audit"] = logging.Audit
+	l["authenticator"] = logging.Authenticator
+	l["controller_manager"] = logging.ControllerManager
+	l["scheduler"] = logging.Scheduler
+
+	return []interface{}{l}
+}
+
+func flattenEksVpcConfig(vpc *tanzuclient.EksVpcConfig) []interface{} {
+	if vpc == nil {
+		return []interface{}{}
+	}
+
+	v := make(map[string]interface{})
+
+	v["subnet_ids"] = vpc.SubnetIds
+	v["security_groups"] = vpc.SecurityGroups
+	v["enable_private_access"] = vpc.EnablePrivateAccess
+	v["enable_public_access"] = vpc.EnablePublicAccess
+	v["public_access_cidrs"] = vpc.PublicAccessCidrs
+
+	return []interface{}{v}
+}
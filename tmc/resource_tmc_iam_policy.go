@@ -0,0 +1,303 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Scopes IAM policies can be attached to
+var iamPolicyScopes = []string{
+	tanzuclient.OrganizationScope,
+	tanzuclient.ClusterGroupScope,
+	tanzuclient.WorkspaceScope,
+	tanzuclient.ClusterScope,
+	tanzuclient.NamespaceScope,
+}
+
+func resourceTmcIamPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcIamPolicyRead,
+		CreateContext: resourceTmcIamPolicyCreate,
+		UpdateContext: resourceTmcIamPolicyUpdate,
+		DeleteContext: resourceTmcIamPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcIamPolicyImport,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateRoleBindings(d.Get("role_binding").(*schema.Set).List())
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Scope of the IAM Policy",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the IAM Policy",
+			},
+			"scope": scopeSchema(iamPolicyScopes...),
+			"role_binding": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Role bindings of the IAM Policy, one per role, any binding not listed is removed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the role",
+						},
+						"users": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Users bound to the role",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Groups bound to the role",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"service_accounts": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Service accounts bound to the role",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTmcIamPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := client.GetAccessPolicy(scope)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read IAM policy",
+			Detail:   fmt.Sprintf("Error reading IAM policy of %s: %s", scopeID(scope), err),
+		})
+		return diags
+	}
+
+	if policy.Meta != nil {
+		d.Set("resource_version", policy.Meta.ResourceVersion)
+	}
+
+	if err := d.Set("role_binding", flattenRoleBindings(policy.RoleBindings)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read IAM policy",
+			Detail:   fmt.Sprintf("Error setting role bindings of %s: %s", scopeID(scope), err),
+		})
+		return diags
+	}
+
+	d.SetId(scopeID(scope))
+
+	return diags
+}
+
+func resourceTmcIamPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	roleBindings, err := buildRoleBindings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := setIamPolicy(d, meta, roleBindings); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcIamPolicyRead(ctx, d, meta)
+}
+
+func resourceTmcIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("role_binding") {
+		roleBindings, err := buildRoleBindings(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if diags := setIamPolicy(d, meta, roleBindings); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceTmcIamPolicyRead(ctx, d, meta)
+}
+
+// Destroying the IAM policy removes all the role bindings of its scope
+func resourceTmcIamPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := setIamPolicy(d, meta, nil); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceTmcIamPolicyImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	scope, rest, err := parseScopeID(d.Id(), iamPolicyScopes...)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("invalid import ID %q, expected the scope of the IAM policy", d.Id())
+	}
+
+	if err := d.Set("scope", flattenScope(scope)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Replaces the role bindings of the policy's scope. The current resource version is
+// read first, as TMC rejects updates of a policy which does not carry it.
+func setIamPolicy(d *schema.ResourceData, meta interface{}, roleBindings []tanzuclient.RoleBinding) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := client.GetAccessPolicy(scope)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set IAM policy",
+			Detail:   fmt.Sprintf("Error reading IAM policy of %s: %s", scopeID(scope), err),
+		})
+		return diags
+	}
+
+	resourceVersion := ""
+	if current.Meta != nil {
+		resourceVersion = current.Meta.ResourceVersion
+	}
+
+	if _, err := client.SetAccessPolicy(scope, resourceVersion, roleBindings); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set IAM policy",
+			Detail:   fmt.Sprintf("Error setting IAM policy of %s: %s", scopeID(scope), err),
+		})
+		return diags
+	}
+
+	d.SetId(scopeID(scope))
+
+	return diags
+}
+
+// TMC keeps a single binding per role, the subjects of a role are all listed in its binding
+func validateRoleBindings(bindings []interface{}) error {
+	roles := map[string]bool{}
+
+	for _, b := range bindings {
+		if b == nil {
+			continue
+		}
+		// The role is empty while it is not known yet
+		role := b.(map[string]interface{})["role"].(string)
+		if role == "" {
+			continue
+		}
+		if roles[role] {
+			return fmt.Errorf("role %s is bound by more than one role_binding block, list all its subjects in a single block", role)
+		}
+		roles[role] = true
+	}
+
+	return nil
+}
+
+func buildRoleBindings(d *schema.ResourceData) ([]tanzuclient.RoleBinding, error) {
+	list := d.Get("role_binding").(*schema.Set).List()
+	if err := validateRoleBindings(list); err != nil {
+		return nil, err
+	}
+
+	bindings := make([]tanzuclient.RoleBinding, 0, len(list))
+
+	for _, b := range list {
+		binding := b.(map[string]interface{})
+		roleBinding := tanzuclient.RoleBinding{
+			Role:     binding["role"].(string),
+			Subjects: []tanzuclient.Subject{},
+		}
+
+		for _, kind := range subjectKinds {
+			key := subjectKindAttributes[kind]
+			for _, name := range expandStringList(binding[key].(*schema.Set).List()) {
+				roleBinding.Subjects = append(roleBinding.Subjects, tanzuclient.Subject{Name: name, Kind: kind})
+			}
+		}
+
+		bindings = append(bindings, roleBinding)
+	}
+
+	return bindings, nil
+}
+
+func flattenRoleBindings(bindings []tanzuclient.RoleBinding) []interface{} {
+	out := make([]interface{}, 0, len(bindings))
+
+	for _, binding := range bindings {
+		subjects := map[string][]interface{}{}
+		for _, key := range subjectKindAttributes {
+			subjects[key] = make([]interface{}, 0)
+		}
+
+		for _, subject := range binding.Subjects {
+			if key, ok := subjectKindAttributes[subject.Kind]; ok {
+				subjects[key] = append(subjects[key], subject.Name)
+			}
+		}
+
+		b := map[string]interface{}{
+			"role": binding.Role,
+		}
+		for key, names := range subjects {
+			b[key] = names
+		}
+		out = append(out, b)
+	}
+
+	return out
+}
+
+var subjectKinds = []string{
+	tanzuclient.SubjectKindUser,
+	tanzuclient.SubjectKindGroup,
+	tanzuclient.SubjectKindServiceAccount,
+}
+
+// Attributes of a role binding holding the subjects of each kind
+var subjectKindAttributes = map[string]string{
+	tanzuclient.SubjectKindUser:           "users",
+	tanzuclient.SubjectKindGroup:          "groups",
+	tanzuclient.SubjectKindServiceAccount: "service_accounts",
+}
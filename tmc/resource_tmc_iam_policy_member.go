@@ -0,0 +1,188 @@
+package tmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTmcIamPolicyMember() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcIamPolicyMemberRead,
+		CreateContext: resourceTmcIamPolicyMemberCreate,
+		DeleteContext: resourceTmcIamPolicyMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTmcIamPolicyMemberImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Scope, role and subject of the role binding",
+			},
+			"scope": scopeSchema(iamPolicyScopes...),
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role",
+			},
+			"subject_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the identity bound to the role",
+			},
+			"subject_kind": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Kind of the identity bound to the role, one of USER, GROUP or SERVICEACCOUNT",
+				ValidateFunc: validation.StringInSlice(subjectKinds, false),
+			},
+		},
+	}
+}
+
+func resourceTmcIamPolicyMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := client.GetAccessPolicy(scope)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read IAM policy member",
+			Detail:   fmt.Sprintf("Error reading IAM policy of %s: %s", scopeID(scope), err),
+		})
+		return diags
+	}
+
+	role := d.Get("role").(string)
+	subject := buildIamPolicySubject(d)
+
+	if !hasRoleBinding(policy, role, subject) {
+		// The binding was removed outside of Terraform
+		d.SetId("")
+		return diags
+	}
+
+	d.SetId(iamPolicyMemberID(scope, role, subject))
+
+	return diags
+}
+
+func resourceTmcIamPolicyMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role := d.Get("role").(string)
+	subject := buildIamPolicySubject(d)
+
+	if _, err := client.AddRoleBinding(scope, role, subject); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create IAM policy member",
+			Detail:   fmt.Sprintf("Error binding role %s to %s on %s: %s", role, subject.Name, scopeID(scope), err),
+		})
+		return diags
+	}
+
+	d.SetId(iamPolicyMemberID(scope, role, subject))
+
+	return resourceTmcIamPolicyMemberRead(ctx, d, meta)
+}
+
+func resourceTmcIamPolicyMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*tanzuclient.Client)
+
+	var diags diag.Diagnostics
+
+	scope, err := expandScope(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role := d.Get("role").(string)
+	subject := buildIamPolicySubject(d)
+
+	if _, err := client.DeleteRoleBinding(scope, role, subject); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete IAM policy member",
+			Detail:   fmt.Sprintf("Error unbinding role %s from %s on %s: %s", role, subject.Name, scopeID(scope), err),
+		})
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Members are imported with the scope of the policy followed by <role>/<subject_kind>/<subject_name>
+func resourceTmcIamPolicyMemberImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	scope, rest, err := parseScopeID(d.Id(), iamPolicyScopes...)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < 3 || rest[0] == "" || rest[1] == "" || rest[2] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <scope>/<role>/<subject_kind>/<subject_name>", d.Id())
+	}
+
+	if err := d.Set("scope", flattenScope(scope)); err != nil {
+		return nil, err
+	}
+	d.Set("role", rest[0])
+	d.Set("subject_kind", rest[1])
+	// Subject names such as service accounts may contain slashes themselves
+	d.Set("subject_name", strings.Join(rest[2:], "/"))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildIamPolicySubject(d *schema.ResourceData) *tanzuclient.Subject {
+	return &tanzuclient.Subject{
+		Name: d.Get("subject_name").(string),
+		Kind: d.Get("subject_kind").(string),
+	}
+}
+
+func hasRoleBinding(policy *tanzuclient.AccessPolicy, role string, subject *tanzuclient.Subject) bool {
+	for _, binding := range policy.RoleBindings {
+		if binding.Role == role && bindsSubject(binding, subject) {
+			return true
+		}
+	}
+	return false
+}
+
+func bindsSubject(binding tanzuclient.RoleBinding, subject *tanzuclient.Subject) bool {
+	for _, s := range binding.Subjects {
+		if s.Name == subject.Name && s.Kind == subject.Kind {
+			return true
+		}
+	}
+	return false
+}
+
+func iamPolicyMemberID(scope *tanzuclient.Scope, role string, subject *tanzuclient.Subject) string {
+	return strings.Join([]string{scopeID(scope), role, subject.Kind, subject.Name}, "/")
+}
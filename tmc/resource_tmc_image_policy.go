@@ -0,0 +1,289 @@
+package tmc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Scopes image policies can be attached to
+var imagePolicyScopes = []string{
+	tanzuclient.OrganizationScope,
+	tanzuclient.WorkspaceScope,
+}
+
+// Patterns of the fields of the image policy rules, an asterisk matches any characters
+var (
+	imageHostnamePattern = regexp.MustCompile(`^[A-Za-z0-9*]([A-Za-z0-9*.-]*[A-Za-z0-9*])?$`)
+	imageNamePattern     = regexp.MustCompile(`^[a-z0-9*]([a-z0-9*._/-]*[a-z0-9*])?$`)
+	imageTagPattern      = regexp.MustCompile(`^[A-Za-z0-9_*][A-Za-z0-9_.*-]{0,127}$`)
+)
+
+func resourceTmcImagePolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcImagePolicyRead,
+		CreateContext: resourceTmcImagePolicyCreate,
+		UpdateContext: resourceTmcImagePolicyUpdate,
+		DeleteContext: resourceTmcPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: policyImporter(imagePolicyScopes...),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Scope and name of the Image Policy",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the Image Policy",
+			},
+			"name":  policyNameSchema(),
+			"scope": scopeSchema(imagePolicyScopes...),
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the Image Policy",
+			},
+			"recipe": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Recipe of the Image Policy, one of allowed-name-tag, custom, block-latest-tag or require-digest",
+				ValidateFunc: validation.StringInSlice([]string{tanzuclient.ImagePolicyAllowedNameTagRecipe, tanzuclient.ImagePolicyCustomRecipe, tanzuclient.ImagePolicyBlockLatestTagRecipe, tanzuclient.ImagePolicyRequireDigestRecipe}, false),
+			},
+			"audit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only report violations of the Image Policy instead of denying the workloads",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules matching the allowed images, required by the allowed-name-tag and custom recipes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Hostname of the registry, e.g. registry.example.com or *.example.com. Only supported by the custom recipe",
+							ValidateFunc: validation.StringMatch(imageHostnamePattern, "must be a hostname, optionally containing * wildcards"),
+						},
+						"port": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Port of the registry. Only supported by the custom recipe",
+							ValidateFunc: validateImagePort,
+						},
+						"image_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Name of the image, e.g. library/nginx or team/*",
+							ValidateFunc: validation.StringMatch(imageNamePattern, "must be an image name, optionally containing * wildcards"),
+						},
+						"tag": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Tag of the image, e.g. v1.*",
+							ValidateFunc: validation.StringMatch(imageTagPattern, "must be an image tag, optionally containing * wildcards"),
+						},
+						"negate_tag": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Match the images whose tag does not match the tag",
+						},
+						"require_digest": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Require the images to be referenced by digest. Only supported by the custom recipe",
+						},
+					},
+				},
+			},
+			"namespace_selector": namespaceSelectorSchema(),
+		},
+	}
+}
+
+func validateImagePort(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if v == "*" {
+		return
+	}
+	if port, err := strconv.Atoi(v); err != nil || port < 1 || port > 65535 {
+		errs = append(errs, fmt.Errorf("%s must be a port between 1 and 65535 or *, got %q", key, v))
+	}
+	return
+}
+
+func resourceTmcImagePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policy, diags := readPolicy(d, meta, tanzuclient.ImagePolicyType)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := tanzuclient.ImagePolicyInput{}
+	if len(policy.Spec.Input) > 0 {
+		if err := json.Unmarshal(policy.Spec.Input, &input); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read image policy",
+				Detail:   fmt.Sprintf("Error parsing the input of resource %s: %s", d.Id(), err),
+			})
+			return diags
+		}
+	}
+
+	d.Set("recipe", policy.Spec.Recipe)
+	d.Set("audit", input.Audit)
+
+	if err := d.Set("rule", flattenImagePolicyRules(input.Rules)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read image policy",
+			Detail:   fmt.Sprintf("Error setting the rules of resource %s: %s", d.Id(), err),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func resourceTmcImagePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec, err := buildImagePolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := createPolicy(d, meta, spec); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcImagePolicyRead(ctx, d, meta)
+}
+
+func resourceTmcImagePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec, err := buildImagePolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := updatePolicy(d, meta, spec); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcImagePolicyRead(ctx, d, meta)
+}
+
+func buildImagePolicySpec(d *schema.ResourceData) (*tanzuclient.PolicySpec, error) {
+	recipe := d.Get("recipe").(string)
+
+	rules, err := expandImagePolicyRules(recipe, d.Get("rule").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	input := &tanzuclient.ImagePolicyInput{
+		Audit: d.Get("audit").(bool),
+		Rules: rules,
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceSelector, err := expandNamespaceSelector(d.Get("namespace_selector").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tanzuclient.PolicySpec{
+		Type:              tanzuclient.ImagePolicyType,
+		Recipe:            recipe,
+		RecipeVersion:     "v1",
+		Input:             data,
+		NamespaceSelector: namespaceSelector,
+	}, nil
+}
+
+// The rules accepted depend on the recipe, which is only known once the whole configuration is read
+func expandImagePolicyRules(recipe string, in []interface{}) ([]tanzuclient.ImagePolicyRule, error) {
+	switch recipe {
+	case tanzuclient.ImagePolicyBlockLatestTagRecipe, tanzuclient.ImagePolicyRequireDigestRecipe:
+		if len(in) > 0 {
+			return nil, fmt.Errorf("rules are not supported by the %s recipe", recipe)
+		}
+		return nil, nil
+	}
+
+	if len(in) == 0 {
+		return nil, fmt.Errorf("at least one rule is required by the %s recipe", recipe)
+	}
+
+	rules := make([]tanzuclient.ImagePolicyRule, 0, len(in))
+	for i, r := range in {
+		if r == nil {
+			return nil, fmt.Errorf("rule %d must match at least an image name or tag", i)
+		}
+		rule := r.(map[string]interface{})
+
+		imageRule := tanzuclient.ImagePolicyRule{
+			Hostname:      rule["hostname"].(string),
+			ImageName:     rule["image_name"].(string),
+			Port:          rule["port"].(string),
+			RequireDigest: rule["require_digest"].(bool),
+		}
+		if tag := rule["tag"].(string); tag != "" {
+			imageRule.Tag = &tanzuclient.ImagePolicyTag{
+				Value:  tag,
+				Negate: rule["negate_tag"].(bool),
+			}
+		} else if rule["negate_tag"].(bool) {
+			return nil, fmt.Errorf("rule %d sets negate_tag without a tag", i)
+		}
+
+		if recipe == tanzuclient.ImagePolicyAllowedNameTagRecipe {
+			if imageRule.Hostname != "" || imageRule.Port != "" || imageRule.RequireDigest {
+				return nil, fmt.Errorf("rule %d: hostname, port and require_digest are only supported by the custom recipe", i)
+			}
+			if imageRule.ImageName == "" && imageRule.Tag == nil {
+				return nil, fmt.Errorf("rule %d must match at least an image name or tag", i)
+			}
+		} else if imageRule.Hostname == "" && imageRule.ImageName == "" && imageRule.Port == "" && imageRule.Tag == nil && !imageRule.RequireDigest {
+			return nil, fmt.Errorf("rule %d must set at least one of hostname, port, image_name, tag or require_digest", i)
+		}
+
+		rules = append(rules, imageRule)
+	}
+
+	return rules, nil
+}
+
+func flattenImagePolicyRules(rules []tanzuclient.ImagePolicyRule) []interface{} {
+	out := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		r := map[string]interface{}{
+			"hostname":       rule.Hostname,
+			"image_name":     rule.ImageName,
+			"port":           rule.Port,
+			"require_digest": rule.RequireDigest,
+		}
+		if rule.Tag != nil {
+			r["tag"] = rule.Tag.Value
+			r["negate_tag"] = rule.Tag.Negate
+		}
+		out = append(out, r)
+	}
+	return out
+}
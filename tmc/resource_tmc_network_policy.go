@@ -0,0 +1,431 @@
+package tmc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Network policies can only be attached to workspaces
+var networkPolicyScopes = []string{
+	tanzuclient.WorkspaceScope,
+}
+
+func resourceTmcNetworkPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcNetworkPolicyRead,
+		CreateContext: resourceTmcNetworkPolicyCreate,
+		UpdateContext: resourceTmcNetworkPolicyUpdate,
+		DeleteContext: resourceTmcPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: policyImporter(networkPolicyScopes...),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Scope and name of the Network Policy",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the Network Policy",
+			},
+			"name":  policyNameSchema(),
+			"scope": scopeSchema(networkPolicyScopes...),
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the Network Policy",
+			},
+			"recipe": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Recipe of the Network Policy, one of allow-all, allow-all-to-pods, deny-all, deny-all-to-pods, custom-egress or custom-ingress",
+				ValidateFunc: validation.StringInSlice([]string{
+					tanzuclient.NetworkPolicyAllowAllRecipe,
+					tanzuclient.NetworkPolicyAllowAllToPodsRecipe,
+					tanzuclient.NetworkPolicyDenyAllRecipe,
+					tanzuclient.NetworkPolicyDenyAllToPodsRecipe,
+					tanzuclient.NetworkPolicyCustomEgressRecipe,
+					tanzuclient.NetworkPolicyCustomIngressRecipe,
+				}, false),
+			},
+			"from_own_namespace": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only allow traffic from the pods of the same namespace. Only supported by the allow-all and allow-all-to-pods recipes",
+			},
+			"to_pod_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Labels of the pods the Network Policy applies to. Required by the allow-all-to-pods and deny-all-to-pods recipes, optional for the custom recipes",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules of the allowed traffic, required by the custom-egress and custom-ingress recipes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Ports of the allowed traffic, any port when omitted",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"port": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Number or name of the port",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "TCP",
+										Description:  "Protocol of the port, one of TCP or UDP",
+										ValidateFunc: validation.StringInSlice([]string{"TCP", "UDP"}, false),
+									},
+								},
+							},
+						},
+						"pod_selector": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Selects the pods of the namespace traffic is allowed from or to",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"labels": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Description: "Labels of the pods",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"namespace_selector": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Selects the namespaces traffic is allowed from or to",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"labels": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Description: "Labels of the namespaces",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"ip_block": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "IP ranges traffic is allowed from or to",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cidr": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "CIDR of the IP range",
+										ValidateFunc: validation.IsCIDR,
+									},
+									"except": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "CIDRs excluded from the IP range",
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsCIDR,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"namespace_selector": namespaceSelectorSchema(),
+		},
+	}
+}
+
+func resourceTmcNetworkPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policy, diags := readPolicy(d, meta, tanzuclient.NetworkPolicyType)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := tanzuclient.NetworkPolicyInput{}
+	if len(policy.Spec.Input) > 0 {
+		if err := json.Unmarshal(policy.Spec.Input, &input); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read network policy",
+				Detail:   fmt.Sprintf("Error parsing the input of resource %s: %s", d.Id(), err),
+			})
+			return diags
+		}
+	}
+
+	d.Set("recipe", policy.Spec.Recipe)
+	d.Set("from_own_namespace", boolValue(input.FromOwnNamespace))
+
+	if err := d.Set("to_pod_labels", flattenPolicyLabels(input.ToPodLabels)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read network policy",
+			Detail:   fmt.Sprintf("Error setting the pod labels of resource %s: %s", d.Id(), err),
+		})
+		return diags
+	}
+
+	if err := d.Set("rule", flattenNetworkPolicyRules(input.Rules)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read network policy",
+			Detail:   fmt.Sprintf("Error setting the rules of resource %s: %s", d.Id(), err),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func resourceTmcNetworkPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec, err := buildNetworkPolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := createPolicy(d, meta, spec); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcNetworkPolicyRead(ctx, d, meta)
+}
+
+func resourceTmcNetworkPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec, err := buildNetworkPolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := updatePolicy(d, meta, spec); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcNetworkPolicyRead(ctx, d, meta)
+}
+
+// Each recipe only accepts some of the inputs, the others are rejected rather than ignored
+func buildNetworkPolicySpec(d *schema.ResourceData) (*tanzuclient.PolicySpec, error) {
+	recipe := d.Get("recipe").(string)
+	fromOwnNamespace := d.Get("from_own_namespace").(bool)
+	toPodLabels := expandPolicyLabels(d.Get("to_pod_labels").(map[string]interface{}))
+	rules := d.Get("rule").([]interface{})
+
+	input := &tanzuclient.NetworkPolicyInput{}
+
+	switch recipe {
+	case tanzuclient.NetworkPolicyAllowAllRecipe, tanzuclient.NetworkPolicyAllowAllToPodsRecipe:
+		input.FromOwnNamespace = boolPtr(fromOwnNamespace)
+	default:
+		if fromOwnNamespace {
+			return nil, fmt.Errorf("from_own_namespace is not supported by the %s recipe", recipe)
+		}
+	}
+
+	switch recipe {
+	case tanzuclient.NetworkPolicyAllowAllToPodsRecipe, tanzuclient.NetworkPolicyDenyAllToPodsRecipe:
+		if len(toPodLabels) == 0 {
+			return nil, fmt.Errorf("to_pod_labels is required by the %s recipe", recipe)
+		}
+		input.ToPodLabels = toPodLabels
+	case tanzuclient.NetworkPolicyCustomEgressRecipe, tanzuclient.NetworkPolicyCustomIngressRecipe:
+		input.ToPodLabels = toPodLabels
+	default:
+		if len(toPodLabels) > 0 {
+			return nil, fmt.Errorf("to_pod_labels is not supported by the %s recipe", recipe)
+		}
+	}
+
+	switch recipe {
+	case tanzuclient.NetworkPolicyCustomEgressRecipe, tanzuclient.NetworkPolicyCustomIngressRecipe:
+		if len(rules) == 0 {
+			return nil, fmt.Errorf("at least one rule is required by the %s recipe", recipe)
+		}
+		expanded, err := expandNetworkPolicyRules(rules)
+		if err != nil {
+			return nil, err
+		}
+		input.Rules = expanded
+	default:
+		if len(rules) > 0 {
+			return nil, fmt.Errorf("rules are not supported by the %s recipe", recipe)
+		}
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceSelector, err := expandNamespaceSelector(d.Get("namespace_selector").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tanzuclient.PolicySpec{
+		Type:              tanzuclient.NetworkPolicyType,
+		Recipe:            recipe,
+		RecipeVersion:     "v1",
+		Input:             data,
+		NamespaceSelector: namespaceSelector,
+	}, nil
+}
+
+func expandNetworkPolicyRules(in []interface{}) ([]tanzuclient.NetworkPolicyRule, error) {
+	rules := make([]tanzuclient.NetworkPolicyRule, 0, len(in))
+
+	for i, r := range in {
+		if r == nil {
+			return nil, fmt.Errorf("rule %d must set at least one port, pod_selector, namespace_selector or ip_block", i)
+		}
+		rule := r.(map[string]interface{})
+
+		networkRule := tanzuclient.NetworkPolicyRule{}
+
+		for _, p := range rule["port"].([]interface{}) {
+			if p == nil {
+				continue
+			}
+			port := p.(map[string]interface{})
+			networkRule.Ports = append(networkRule.Ports, tanzuclient.NetworkPolicyPort{
+				Port:     port["port"].(string),
+				Protocol: port["protocol"].(string),
+			})
+		}
+
+		for _, s := range rule["pod_selector"].([]interface{}) {
+			if s == nil {
+				continue
+			}
+			selector := s.(map[string]interface{})
+			networkRule.RuleSpec = append(networkRule.RuleSpec, tanzuclient.NetworkPolicyRuleSpec{
+				PodSelector: &tanzuclient.NetworkPolicyPodSelector{
+					PodSelector: expandPolicyLabels(selector["labels"].(map[string]interface{})),
+				},
+			})
+		}
+
+		for _, s := range rule["namespace_selector"].([]interface{}) {
+			if s == nil {
+				continue
+			}
+			selector := s.(map[string]interface{})
+			networkRule.RuleSpec = append(networkRule.RuleSpec, tanzuclient.NetworkPolicyRuleSpec{
+				NamespaceSelector: &tanzuclient.NetworkPolicyNamespaceSelector{
+					NamespaceSelector: expandPolicyLabels(selector["labels"].(map[string]interface{})),
+				},
+			})
+		}
+
+		for _, b := range rule["ip_block"].([]interface{}) {
+			if b == nil {
+				continue
+			}
+			block := b.(map[string]interface{})
+			networkRule.RuleSpec = append(networkRule.RuleSpec, tanzuclient.NetworkPolicyRuleSpec{
+				IPBlock: &tanzuclient.NetworkPolicyIPBlock{
+					Cidr:   block["cidr"].(string),
+					Except: expandStringList(block["except"].([]interface{})),
+				},
+			})
+		}
+
+		if len(networkRule.Ports) == 0 && len(networkRule.RuleSpec) == 0 {
+			return nil, fmt.Errorf("rule %d must set at least one port, pod_selector, namespace_selector or ip_block", i)
+		}
+
+		rules = append(rules, networkRule)
+	}
+
+	return rules, nil
+}
+
+func flattenNetworkPolicyRules(rules []tanzuclient.NetworkPolicyRule) []interface{} {
+	out := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		ports := make([]interface{}, 0, len(rule.Ports))
+		for _, port := range rule.Ports {
+			ports = append(ports, map[string]interface{}{
+				"port":     port.Port,
+				"protocol": port.Protocol,
+			})
+		}
+
+		podSelectors := make([]interface{}, 0)
+		namespaceSelectors := make([]interface{}, 0)
+		ipBlocks := make([]interface{}, 0)
+
+		for _, spec := range rule.RuleSpec {
+			switch {
+			case spec.PodSelector != nil:
+				podSelectors = append(podSelectors, map[string]interface{}{
+					"labels": flattenPolicyLabels(spec.PodSelector.PodSelector),
+				})
+			case spec.NamespaceSelector != nil:
+				namespaceSelectors = append(namespaceSelectors, map[string]interface{}{
+					"labels": flattenPolicyLabels(spec.NamespaceSelector.NamespaceSelector),
+				})
+			case spec.IPBlock != nil:
+				ipBlocks = append(ipBlocks, map[string]interface{}{
+					"cidr":   spec.IPBlock.Cidr,
+					"except": spec.IPBlock.Except,
+				})
+			}
+		}
+
+		out = append(out, map[string]interface{}{
+			"port":               ports,
+			"pod_selector":       podSelectors,
+			"namespace_selector": namespaceSelectors,
+			"ip_block":           ipBlocks,
+		})
+	}
+
+	return out
+}
+
+// Labels are sent as a list of key and value pairs, sorted by key to keep the input stable
+func expandPolicyLabels(labels map[string]interface{}) []tanzuclient.PolicyLabel {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([]tanzuclient.PolicyLabel, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, tanzuclient.PolicyLabel{Key: k, Value: labels[k].(string)})
+	}
+	return out
+}
+
+func flattenPolicyLabels(labels []tanzuclient.PolicyLabel) map[string]interface{} {
+	out := make(map[string]interface{}, len(labels))
+	for _, label := range labels {
+		out[label.Key] = label.Value
+	}
+	return out
+}
@@ -0,0 +1,312 @@
+package tmc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Scopes quota policies can be attached to
+var quotaPolicyScopes = []string{
+	tanzuclient.OrganizationScope,
+	tanzuclient.ClusterGroupScope,
+	tanzuclient.ClusterScope,
+}
+
+// Kubernetes resource quantities, e.g. 500m, 1.5 or 2Gi
+var quantityPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(m|k|Ki|M|Mi|G|Gi|T|Ti|P|Pi|E|Ei)?$`)
+
+func quantitySchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  description,
+		ValidateFunc: validation.StringMatch(quantityPattern, "must be a Kubernetes quantity, e.g. 500m or 2Gi"),
+	}
+}
+
+func resourceTmcQuotaPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcQuotaPolicyRead,
+		CreateContext: resourceTmcQuotaPolicyCreate,
+		UpdateContext: resourceTmcQuotaPolicyUpdate,
+		DeleteContext: resourceTmcPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: policyImporter(quotaPolicyScopes...),
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			custom := d.Get("custom").([]interface{})
+			if len(custom) == 0 || custom[0] == nil {
+				return nil
+			}
+			return validateStorageClassQuotas(custom[0].(map[string]interface{})["storage_class"].(*schema.Set).List())
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Scope and name of the Quota Policy",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the Quota Policy",
+			},
+			"name":  policyNameSchema(),
+			"scope": scopeSchema(quotaPolicyScopes...),
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the Quota Policy",
+			},
+			"recipe": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Recipe of the Quota Policy, one of small, medium, large or custom",
+				ValidateFunc: validation.StringInSlice([]string{tanzuclient.QuotaPolicySmallRecipe, tanzuclient.QuotaPolicyMediumRecipe, tanzuclient.QuotaPolicyLargeRecipe, tanzuclient.QuotaPolicyCustomRecipe}, false),
+			},
+			"custom": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Quotas of the custom recipe, required when the recipe is custom",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"requests_cpu":     quantitySchema("CPU all the pods of a namespace may request"),
+						"requests_memory":  quantitySchema("Memory all the pods of a namespace may request"),
+						"limits_cpu":       quantitySchema("CPU limit of all the pods of a namespace"),
+						"limits_memory":    quantitySchema("Memory limit of all the pods of a namespace"),
+						"requests_storage": quantitySchema("Storage all the persistent volume claims of a namespace may request"),
+						"persistent_volume_claims": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Number of persistent volume claims a namespace may have",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"storage_class": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Quotas of the persistent volume claims of a storage class",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the storage class",
+									},
+									"requests_storage": quantitySchema("Storage the persistent volume claims of the storage class may request"),
+									"persistent_volume_claims": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Description:  "Number of persistent volume claims of the storage class a namespace may have",
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"namespace_selector": namespaceSelectorSchema(),
+		},
+	}
+}
+
+func resourceTmcQuotaPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policy, diags := readPolicy(d, meta, tanzuclient.QuotaPolicyType)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := tanzuclient.QuotaPolicyInput{}
+	if len(policy.Spec.Input) > 0 {
+		if err := json.Unmarshal(policy.Spec.Input, &input); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read quota policy",
+				Detail:   fmt.Sprintf("Error parsing the input of resource %s: %s", d.Id(), err),
+			})
+			return diags
+		}
+	}
+
+	d.Set("recipe", policy.Spec.Recipe)
+
+	custom := []interface{}{}
+	if policy.Spec.Recipe == tanzuclient.QuotaPolicyCustomRecipe {
+		custom = flattenQuotaPolicyCustomInput(&input)
+	}
+	if err := d.Set("custom", custom); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read quota policy",
+			Detail:   fmt.Sprintf("Error setting the custom quotas of resource %s: %s", d.Id(), err),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func resourceTmcQuotaPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec, err := buildQuotaPolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := createPolicy(d, meta, spec); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcQuotaPolicyRead(ctx, d, meta)
+}
+
+func resourceTmcQuotaPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec, err := buildQuotaPolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := updatePolicy(d, meta, spec); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcQuotaPolicyRead(ctx, d, meta)
+}
+
+func buildQuotaPolicySpec(d *schema.ResourceData) (*tanzuclient.PolicySpec, error) {
+	recipe := d.Get("recipe").(string)
+	custom := d.Get("custom").([]interface{})
+
+	if recipe == tanzuclient.QuotaPolicyCustomRecipe && (len(custom) == 0 || custom[0] == nil) {
+		return nil, fmt.Errorf("the custom block is required by the custom recipe")
+	}
+	if recipe != tanzuclient.QuotaPolicyCustomRecipe && len(custom) > 0 {
+		return nil, fmt.Errorf("the custom block can only be set with the custom recipe, not %s", recipe)
+	}
+
+	input := &tanzuclient.QuotaPolicyInput{}
+
+	if recipe == tanzuclient.QuotaPolicyCustomRecipe {
+		c := custom[0].(map[string]interface{})
+
+		input.RequestsCpu = c["requests_cpu"].(string)
+		input.RequestsMemory = c["requests_memory"].(string)
+		input.LimitsCpu = c["limits_cpu"].(string)
+		input.LimitsMemory = c["limits_memory"].(string)
+		input.RequestsStorage = c["requests_storage"].(string)
+		input.PersistentVolumeClaims = c["persistent_volume_claims"].(int)
+
+		storageClasses := c["storage_class"].(*schema.Set).List()
+		if err := validateStorageClassQuotas(storageClasses); err != nil {
+			return nil, err
+		}
+
+		for _, s := range storageClasses {
+			if s == nil {
+				continue
+			}
+			storageClass := s.(map[string]interface{})
+			name := storageClass["name"].(string)
+
+			storage := storageClass["requests_storage"].(string)
+			claims := storageClass["persistent_volume_claims"].(int)
+			if storage == "" && claims == 0 {
+				return nil, fmt.Errorf("storage class %s sets no quota, requests_storage or persistent_volume_claims is required", name)
+			}
+
+			if storage != "" {
+				if input.RequestedStoragePerClass == nil {
+					input.RequestedStoragePerClass = map[string]string{}
+				}
+				input.RequestedStoragePerClass[name] = storage
+			}
+			if claims > 0 {
+				if input.PersistentVolumeClaimsPerClass == nil {
+					input.PersistentVolumeClaimsPerClass = map[string]int{}
+				}
+				input.PersistentVolumeClaimsPerClass[name] = claims
+			}
+		}
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceSelector, err := expandNamespaceSelector(d.Get("namespace_selector").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tanzuclient.PolicySpec{
+		Type:              tanzuclient.QuotaPolicyType,
+		Recipe:            recipe,
+		RecipeVersion:     "v1",
+		Input:             data,
+		NamespaceSelector: namespaceSelector,
+	}, nil
+}
+
+// The quotas of the storage classes are keyed by class, a class can only be listed once
+func validateStorageClassQuotas(storageClasses []interface{}) error {
+	names := map[string]bool{}
+
+	for _, s := range storageClasses {
+		if s == nil {
+			continue
+		}
+		// The name is empty while it is not known yet
+		name := s.(map[string]interface{})["name"].(string)
+		if name == "" {
+			continue
+		}
+		if names[name] {
+			return fmt.Errorf("storage class %s is listed by more than one storage_class block, set all its quotas in a single block", name)
+		}
+		names[name] = true
+	}
+
+	return nil
+}
+
+func flattenQuotaPolicyCustomInput(input *tanzuclient.QuotaPolicyInput) []interface{} {
+	names := make([]string, 0)
+	seen := map[string]bool{}
+	for name := range input.RequestedStoragePerClass {
+		seen[name] = true
+		names = append(names, name)
+	}
+	for name := range input.PersistentVolumeClaimsPerClass {
+		if !seen[name] {
+			names = append(names, name)
+		}
+	}
+	storageClasses := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		storageClasses = append(storageClasses, map[string]interface{}{
+			"name":                     name,
+			"requests_storage":         input.RequestedStoragePerClass[name],
+			"persistent_volume_claims": input.PersistentVolumeClaimsPerClass[name],
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"requests_cpu":             input.RequestsCpu,
+			"requests_memory":          input.RequestsMemory,
+			"limits_cpu":               input.LimitsCpu,
+			"limits_memory":            input.LimitsMemory,
+			"requests_storage":         input.RequestsStorage,
+			"persistent_volume_claims": input.PersistentVolumeClaims,
+			"storage_class":            storageClasses,
+		},
+	}
+}
@@ -0,0 +1,434 @@
+package tmc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Scopes security policies can be attached to
+var securityPolicyScopes = []string{
+	tanzuclient.OrganizationScope,
+	tanzuclient.ClusterGroupScope,
+	tanzuclient.ClusterScope,
+}
+
+func resourceTmcSecurityPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceTmcSecurityPolicyRead,
+		CreateContext: resourceTmcSecurityPolicyCreate,
+		UpdateContext: resourceTmcSecurityPolicyUpdate,
+		DeleteContext: resourceTmcPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: policyImporter(securityPolicyScopes...),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Scope and name of the Security Policy",
+			},
+			"resource_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource version of the Security Policy",
+			},
+			"name":  policyNameSchema(),
+			"scope": scopeSchema(securityPolicyScopes...),
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the Security Policy",
+			},
+			"recipe": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Recipe of the Security Policy, one of baseline, strict or custom",
+				ValidateFunc: validation.StringInSlice([]string{tanzuclient.SecurityPolicyBaselineRecipe, tanzuclient.SecurityPolicyStrictRecipe, tanzuclient.SecurityPolicyCustomRecipe}, false),
+			},
+			"audit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only report violations of the Security Policy instead of denying the workloads",
+			},
+			"disable_native_psp": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the native pod security policies of the clusters",
+			},
+			"custom": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Inputs of the custom recipe, required when the recipe is custom",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_privileged_containers": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Allow containers to run in privileged mode",
+						},
+						"allow_privilege_escalation": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Allow containers to gain more privileges than their parent process",
+						},
+						"allow_host_namespace_sharing": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Allow pods to share the PID and IPC namespaces of the host",
+						},
+						"allow_host_network": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Allow pods to use the network of the host",
+						},
+						"read_only_root_file_system": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Require containers to run with a read only root file system",
+						},
+						"allowed_host_port_range": policyRangeSchema("Range of host ports pods may use", 1),
+						"allowed_volumes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Types of volumes pods may use, e.g. configMap or persistentVolumeClaim, or * for any",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_host_paths": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Host paths pods may mount",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path_prefix": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Prefix of the host paths",
+									},
+									"read_only": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Only allow the host paths to be mounted read only",
+									},
+								},
+							},
+						},
+						"allowed_capabilities": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Linux capabilities containers may add",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"required_drop_capabilities": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Linux capabilities containers must drop",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"run_as_user":         runAsRuleSchema("User IDs containers may run as", []string{"RunAsAny", "MustRunAs", "MustRunAsNonRoot"}),
+						"run_as_group":        runAsRuleSchema("Group IDs containers may run as", []string{"RunAsAny", "MustRunAs", "MayRunAs"}),
+						"supplemental_groups": runAsRuleSchema("Supplemental group IDs of the pods", []string{"RunAsAny", "MustRunAs", "MayRunAs"}),
+						"fs_group":            runAsRuleSchema("Group IDs owning the volumes of the pods", []string{"RunAsAny", "MustRunAs", "MayRunAs"}),
+						"forbidden_sysctls": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Sysctls pods may not set, or * for all",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"namespace_selector": namespaceSelectorSchema(),
+		},
+	}
+}
+
+func policyRangeSchema(description string, maxItems int) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    maxItems,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"max": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func runAsRuleSchema(description string, rules []string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rule": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(rules, false),
+				},
+				"ranges": policyRangeSchema("Ranges of IDs allowed by the MustRunAs and MayRunAs rules", 0),
+			},
+		},
+	}
+}
+
+func resourceTmcSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policy, diags := readPolicy(d, meta, tanzuclient.SecurityPolicyType)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := tanzuclient.SecurityPolicyInput{}
+	if len(policy.Spec.Input) > 0 {
+		if err := json.Unmarshal(policy.Spec.Input, &input); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read security policy",
+				Detail:   fmt.Sprintf("Error parsing the input of resource %s: %s", d.Id(), err),
+			})
+			return diags
+		}
+	}
+
+	d.Set("recipe", policy.Spec.Recipe)
+	d.Set("audit", input.Audit)
+	d.Set("disable_native_psp", input.DisableNativePsp)
+
+	custom := []interface{}{}
+	if policy.Spec.Recipe == tanzuclient.SecurityPolicyCustomRecipe {
+		custom = flattenSecurityPolicyCustomInput(&input)
+	}
+	if err := d.Set("custom", custom); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read security policy",
+			Detail:   fmt.Sprintf("Error setting the custom inputs of resource %s: %s", d.Id(), err),
+		})
+		return diags
+	}
+
+	return diags
+}
+
+func resourceTmcSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec, err := buildSecurityPolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := createPolicy(d, meta, spec); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcSecurityPolicyRead(ctx, d, meta)
+}
+
+func resourceTmcSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec, err := buildSecurityPolicySpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := updatePolicy(d, meta, spec); diags.HasError() {
+		return diags
+	}
+
+	return resourceTmcSecurityPolicyRead(ctx, d, meta)
+}
+
+func buildSecurityPolicySpec(d *schema.ResourceData) (*tanzuclient.PolicySpec, error) {
+	recipe := d.Get("recipe").(string)
+	custom := d.Get("custom").([]interface{})
+
+	if recipe == tanzuclient.SecurityPolicyCustomRecipe && (len(custom) == 0 || custom[0] == nil) {
+		return nil, fmt.Errorf("the custom block is required by the custom recipe")
+	}
+	if recipe != tanzuclient.SecurityPolicyCustomRecipe && len(custom) > 0 {
+		return nil, fmt.Errorf("the custom block can only be set with the custom recipe, not %s", recipe)
+	}
+
+	input := &tanzuclient.SecurityPolicyInput{
+		Audit:            d.Get("audit").(bool),
+		DisableNativePsp: d.Get("disable_native_psp").(bool),
+	}
+
+	if recipe == tanzuclient.SecurityPolicyCustomRecipe {
+		c := custom[0].(map[string]interface{})
+
+		input.AllowPrivilegedContainers = boolPtr(c["allow_privileged_containers"].(bool))
+		input.AllowPrivilegeEscalation = boolPtr(c["allow_privilege_escalation"].(bool))
+		input.AllowHostNamespaceSharing = boolPtr(c["allow_host_namespace_sharing"].(bool))
+		input.AllowHostNetwork = boolPtr(c["allow_host_network"].(bool))
+		input.ReadOnlyRootFileSystem = boolPtr(c["read_only_root_file_system"].(bool))
+		input.AllowedVolumes = expandStringList(c["allowed_volumes"].([]interface{}))
+
+		if ranges := expandPolicyRanges(c["allowed_host_port_range"].([]interface{})); len(ranges) > 0 {
+			input.AllowedHostPortRange = &ranges[0]
+		}
+
+		for _, p := range c["allowed_host_paths"].([]interface{}) {
+			if p == nil {
+				continue
+			}
+			path := p.(map[string]interface{})
+			input.AllowedHostPaths = append(input.AllowedHostPaths, tanzuclient.AllowedHostPath{
+				PathPrefix: path["path_prefix"].(string),
+				ReadOnly:   path["read_only"].(bool),
+			})
+		}
+
+		allowed := expandStringList(c["allowed_capabilities"].([]interface{}))
+		drop := expandStringList(c["required_drop_capabilities"].([]interface{}))
+		if len(allowed) > 0 || len(drop) > 0 {
+			input.LinuxCapabilities = &tanzuclient.LinuxCapabilities{
+				AllowedCapabilities:      allowed,
+				RequiredDropCapabilities: drop,
+			}
+		}
+
+		input.RunAsUser = expandRunAsRule(c["run_as_user"].([]interface{}))
+		input.RunAsGroup = expandRunAsRule(c["run_as_group"].([]interface{}))
+		input.SupplementalGroups = expandRunAsRule(c["supplemental_groups"].([]interface{}))
+		input.FsGroup = expandRunAsRule(c["fs_group"].([]interface{}))
+
+		if forbidden := expandStringList(c["forbidden_sysctls"].([]interface{})); len(forbidden) > 0 {
+			input.Sysctls = &tanzuclient.Sysctls{ForbiddenSysctls: forbidden}
+		}
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceSelector, err := expandNamespaceSelector(d.Get("namespace_selector").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tanzuclient.PolicySpec{
+		Type:              tanzuclient.SecurityPolicyType,
+		Recipe:            recipe,
+		RecipeVersion:     "v1",
+		Input:             data,
+		NamespaceSelector: namespaceSelector,
+	}, nil
+}
+
+func flattenSecurityPolicyCustomInput(input *tanzuclient.SecurityPolicyInput) []interface{} {
+	c := map[string]interface{}{
+		"allow_privileged_containers":  boolValue(input.AllowPrivilegedContainers),
+		"allow_privilege_escalation":   boolValue(input.AllowPrivilegeEscalation),
+		"allow_host_namespace_sharing": boolValue(input.AllowHostNamespaceSharing),
+		"allow_host_network":           boolValue(input.AllowHostNetwork),
+		"read_only_root_file_system":   boolValue(input.ReadOnlyRootFileSystem),
+		"allowed_volumes":              input.AllowedVolumes,
+		"allowed_host_port_range":      []interface{}{},
+		"run_as_user":                  flattenRunAsRule(input.RunAsUser),
+		"run_as_group":                 flattenRunAsRule(input.RunAsGroup),
+		"supplemental_groups":          flattenRunAsRule(input.SupplementalGroups),
+		"fs_group":                     flattenRunAsRule(input.FsGroup),
+	}
+
+	if input.AllowedHostPortRange != nil {
+		c["allowed_host_port_range"] = flattenPolicyRanges([]tanzuclient.PolicyRange{*input.AllowedHostPortRange})
+	}
+
+	paths := make([]interface{}, 0, len(input.AllowedHostPaths))
+	for _, path := range input.AllowedHostPaths {
+		paths = append(paths, map[string]interface{}{
+			"path_prefix": path.PathPrefix,
+			"read_only":   path.ReadOnly,
+		})
+	}
+	c["allowed_host_paths"] = paths
+
+	if input.LinuxCapabilities != nil {
+		c["allowed_capabilities"] = input.LinuxCapabilities.AllowedCapabilities
+		c["required_drop_capabilities"] = input.LinuxCapabilities.RequiredDropCapabilities
+	}
+
+	if input.Sysctls != nil {
+		c["forbidden_sysctls"] = input.Sysctls.ForbiddenSysctls
+	}
+
+	return []interface{}{c}
+}
+
+func expandPolicyRanges(in []interface{}) []tanzuclient.PolicyRange {
+	ranges := make([]tanzuclient.PolicyRange, 0, len(in))
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+		rng := r.(map[string]interface{})
+		ranges = append(ranges, tanzuclient.PolicyRange{
+			Min: rng["min"].(int),
+			Max: rng["max"].(int),
+		})
+	}
+	return ranges
+}
+
+func flattenPolicyRanges(ranges []tanzuclient.PolicyRange) []interface{} {
+	out := make([]interface{}, 0, len(ranges))
+	for _, r := range ranges {
+		out = append(out, map[string]interface{}{
+			"min": r.Min,
+			"max": r.Max,
+		})
+	}
+	return out
+}
+
+func expandRunAsRule(in []interface{}) *tanzuclient.RunAsRule {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	rule := in[0].(map[string]interface{})
+
+	return &tanzuclient.RunAsRule{
+		Rule:   rule["rule"].(string),
+		Ranges: expandPolicyRanges(rule["ranges"].([]interface{})),
+	}
+}
+
+func flattenRunAsRule(rule *tanzuclient.RunAsRule) []interface{} {
+	if rule == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"rule":   rule.Rule,
+			"ranges": flattenPolicyRanges(rule.Ranges),
+		},
+	}
+}
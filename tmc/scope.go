@@ -0,0 +1,272 @@
+package tmc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/codaglobal/terraform-provider-tmc/tanzuclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// scopeSchema returns the schema of the scope block of the resources attached to an object
+// of the TMC resource hierarchy, restricted to the given scope types. Exactly one of the
+// scope types must be set.
+func scopeSchema(scopeTypes ...string) *schema.Schema {
+	attributes := map[string]*schema.Schema{}
+	exactlyOneOf := make([]string, 0, len(scopeTypes))
+
+	for _, scopeType := range scopeTypes {
+		exactlyOneOf = append(exactlyOneOf, "scope.0."+scopeType)
+	}
+
+	for _, scopeType := range scopeTypes {
+		switch scopeType {
+		case tanzuclient.OrganizationScope:
+			attributes[scopeType] = &schema.Schema{
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Attach to the organization",
+				ExactlyOneOf: exactlyOneOf,
+			}
+		case tanzuclient.ClusterGroupScope:
+			attributes[scopeType] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Name of the cluster group to attach to",
+				ExactlyOneOf: exactlyOneOf,
+			}
+		case tanzuclient.WorkspaceScope:
+			attributes[scopeType] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Name of the workspace to attach to",
+				ExactlyOneOf: exactlyOneOf,
+			}
+		case tanzuclient.ClusterScope:
+			attributes[scopeType] = &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Description:  "Cluster to attach to",
+				ExactlyOneOf: exactlyOneOf,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Name of the cluster",
+						},
+						"management_cluster": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Name of the management cluster of the cluster",
+						},
+						"provisioner_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Name of the provisioner of the cluster",
+						},
+					},
+				},
+			}
+		case tanzuclient.NamespaceScope:
+			attributes[scopeType] = &schema.Schema{
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Description:  "Namespace to attach to",
+				ExactlyOneOf: exactlyOneOf,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Name of the namespace",
+						},
+						"cluster_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Name of the cluster of the namespace",
+						},
+						"management_cluster": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Name of the management cluster of the cluster",
+						},
+						"provisioner_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Name of the provisioner of the cluster",
+						},
+					},
+				},
+			}
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: fmt.Sprintf("Object of the resource hierarchy to attach to, one of %s", strings.Join(scopeTypes, ", ")),
+		Elem: &schema.Resource{
+			Schema: attributes,
+		},
+	}
+}
+
+func expandScope(d *schema.ResourceData) (*tanzuclient.Scope, error) {
+	v := d.Get("scope").([]interface{})
+	if len(v) == 0 || v[0] == nil {
+		return nil, fmt.Errorf("scope is required")
+	}
+	scope := v[0].(map[string]interface{})
+
+	if organization, ok := scope[tanzuclient.OrganizationScope].(bool); ok && organization {
+		return &tanzuclient.Scope{Type: tanzuclient.OrganizationScope}, nil
+	}
+	if name, ok := scope[tanzuclient.ClusterGroupScope].(string); ok && name != "" {
+		return &tanzuclient.Scope{Type: tanzuclient.ClusterGroupScope, Name: name}, nil
+	}
+	if name, ok := scope[tanzuclient.WorkspaceScope].(string); ok && name != "" {
+		return &tanzuclient.Scope{Type: tanzuclient.WorkspaceScope, Name: name}, nil
+	}
+	if cluster, ok := scope[tanzuclient.ClusterScope].([]interface{}); ok && len(cluster) > 0 && cluster[0] != nil {
+		c := cluster[0].(map[string]interface{})
+		return &tanzuclient.Scope{
+			Type:                  tanzuclient.ClusterScope,
+			Name:                  c["name"].(string),
+			ManagementClusterName: c["management_cluster"].(string),
+			ProvisionerName:       c["provisioner_name"].(string),
+		}, nil
+	}
+	if namespace, ok := scope[tanzuclient.NamespaceScope].([]interface{}); ok && len(namespace) > 0 && namespace[0] != nil {
+		n := namespace[0].(map[string]interface{})
+		return &tanzuclient.Scope{
+			Type:                  tanzuclient.NamespaceScope,
+			Name:                  n["name"].(string),
+			ClusterName:           n["cluster_name"].(string),
+			ManagementClusterName: n["management_cluster"].(string),
+			ProvisionerName:       n["provisioner_name"].(string),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("scope must set one of its attributes")
+}
+
+func flattenScope(scope *tanzuclient.Scope) []interface{} {
+	s := map[string]interface{}{}
+
+	switch scope.Type {
+	case tanzuclient.OrganizationScope:
+		s[scope.Type] = true
+	case tanzuclient.ClusterGroupScope, tanzuclient.WorkspaceScope:
+		s[scope.Type] = scope.Name
+	case tanzuclient.ClusterScope:
+		s[scope.Type] = []interface{}{
+			map[string]interface{}{
+				"name":               scope.Name,
+				"management_cluster": scope.ManagementClusterName,
+				"provisioner_name":   scope.ProvisionerName,
+			},
+		}
+	case tanzuclient.NamespaceScope:
+		s[scope.Type] = []interface{}{
+			map[string]interface{}{
+				"name":               scope.Name,
+				"cluster_name":       scope.ClusterName,
+				"management_cluster": scope.ManagementClusterName,
+				"provisioner_name":   scope.ProvisionerName,
+			},
+		}
+	}
+
+	return []interface{}{s}
+}
+
+// scopeID returns the slash-separated identifier of a scope, as used in import IDs:
+//
+//	organization
+//	cluster_group/<name>
+//	workspace/<name>
+//	cluster/<management_cluster>/<provisioner_name>/<name>
+//	namespace/<management_cluster>/<provisioner_name>/<cluster_name>/<name>
+func scopeID(scope *tanzuclient.Scope) string {
+	switch scope.Type {
+	case tanzuclient.ClusterGroupScope, tanzuclient.WorkspaceScope:
+		return strings.Join([]string{scope.Type, scope.Name}, "/")
+	case tanzuclient.ClusterScope:
+		return strings.Join([]string{scope.Type, scope.ManagementClusterName, scope.ProvisionerName, scope.Name}, "/")
+	case tanzuclient.NamespaceScope:
+		return strings.Join([]string{scope.Type, scope.ManagementClusterName, scope.ProvisionerName, scope.ClusterName, scope.Name}, "/")
+	}
+	return scope.Type
+}
+
+// parseScopeID parses the scope at the start of a slash-separated import ID and returns
+// the parts of the ID following it. Only the given scope types are accepted.
+func parseScopeID(id string, scopeTypes ...string) (*tanzuclient.Scope, []string, error) {
+	parts := strings.Split(id, "/")
+
+	allowed := false
+	for _, scopeType := range scopeTypes {
+		if parts[0] == scopeType {
+			allowed = true
+		}
+	}
+	if !allowed {
+		return nil, nil, fmt.Errorf("invalid scope in ID %q, expected one of %s", id, strings.Join(scopeTypes, ", "))
+	}
+
+	var length int
+	switch parts[0] {
+	case tanzuclient.OrganizationScope:
+		length = 1
+	case tanzuclient.ClusterGroupScope, tanzuclient.WorkspaceScope:
+		length = 2
+	case tanzuclient.ClusterScope:
+		length = 4
+	case tanzuclient.NamespaceScope:
+		length = 5
+	}
+
+	if len(parts) < length {
+		return nil, nil, fmt.Errorf("invalid scope in ID %q", id)
+	}
+	for _, part := range parts[:length] {
+		if part == "" {
+			return nil, nil, fmt.Errorf("invalid scope in ID %q", id)
+		}
+	}
+
+	scope := &tanzuclient.Scope{Type: parts[0]}
+
+	switch scope.Type {
+	case tanzuclient.ClusterGroupScope, tanzuclient.WorkspaceScope:
+		scope.Name = parts[1]
+	case tanzuclient.ClusterScope:
+		scope.ManagementClusterName = parts[1]
+		scope.ProvisionerName = parts[2]
+		scope.Name = parts[3]
+	case tanzuclient.NamespaceScope:
+		scope.ManagementClusterName = parts[1]
+		scope.ProvisionerName = parts[2]
+		scope.ClusterName = parts[3]
+		scope.Name = parts[4]
+	}
+
+	return scope, parts[length:], nil
+}
@@ -1,10 +1,13 @@
 package tmc
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func IsValidTanzuName(name string) bool {
@@ -36,3 +39,44 @@ func isValidK8sProviderType(lookup string) bool {
 	}
 	return false
 }
+
+// expandStringList converts a list read from the schema into a slice of strings
+func expandStringList(in []interface{}) []string {
+	result := make([]string, 0, len(in))
+	for _, v := range in {
+		if s, ok := v.(string); ok && s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// boolValue returns the value of an optional boolean, false when it is unset
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+// suppressEquivalentJSON ignores the differences in formatting and key order of JSON documents
+func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
+	var o, n interface{}
+	if err := json.Unmarshal([]byte(old), &o); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &n); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(o, n)
+}